@@ -15,10 +15,16 @@ func main() {
 
 	log := logger.SetupLogger(cfg.Env)
 
-	application := app.New(log, cfg.GRPC.Port, cfg.StoragePath, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+	application := app.New(log, cfg.GRPC.Port, cfg.HTTP.Port, cfg.StoragePath, cfg.AccessTokenTTL, cfg.RefreshTokenTTL, cfg.PasswordReset, cfg.Mail, cfg.StatusWorkflow, cfg.Reminders, cfg.Attachments, cfg.Trash, cfg.Watch)
 
 	go application.GRPCServer.MustRun()
 
+	go application.HTTPServer.MustRun()
+
+	go application.Reminders.Run()
+
+	go application.Trash.Run()
+
 	stop := make(chan os.Signal, 1)
 
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
@@ -27,6 +33,12 @@ func main() {
 
 	log.Info("stopping application", slog.String("signal", sign.String()))
 
+	application.Reminders.Stop()
+
+	application.Trash.Stop()
+
+	application.HTTPServer.Stop()
+
 	application.GRPCServer.Stop()
 
 	log.Info("stopping application")
@@ -1,43 +1,138 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	grpcapp "server/internal/app/grpc"
+	httpapp "server/internal/app/http"
+	"server/internal/blobstore/local"
+	"server/internal/config"
+	"server/internal/lib/hub"
+	"server/internal/lib/mailer"
+	"server/internal/lib/notifier"
+	"server/internal/services/attachments"
+	"server/internal/services/calendar"
+	"server/internal/services/comments"
+	"server/internal/services/labels"
+	"server/internal/services/projects"
+	"server/internal/services/reminders"
+	"server/internal/services/statuses"
 	"server/internal/services/tasks"
+	"server/internal/services/trash"
 	"server/internal/services/user"
+	"server/internal/services/workspaces"
+	"server/internal/storage"
 	"server/internal/storage/sqlite"
 	"time"
 )
 
 type App struct {
 	GRPCServer *grpcapp.App
+	HTTPServer *httpapp.App
+	Reminders  *reminders.Scheduler
+	Trash      *trash.Purger
 }
 
 func New(
 	log *slog.Logger,
 	grpcPort int,
+	httpPort int,
 	storagePath string,
 	accessTokenTTL time.Duration,
 	refreshTokenTTL time.Duration,
+	passwordResetConfig config.PasswordResetConfig,
+	mailConfig config.MailConfig,
+	statusWorkflow config.StatusWorkflowConfig,
+	remindersConfig config.RemindersConfig,
+	attachmentsConfig config.AttachmentsConfig,
+	trashConfig config.TrashConfig,
+	watchConfig config.WatchConfig,
 ) *App {
-	userStorage, err := sqlite.NewUserStorage(storagePath)
+	db, err := sqlite.Open(storagePath)
 
 	if err != nil {
 		panic(err)
 	}
 
-	taskStorage, err := sqlite.NewTaskStorage(storagePath)
+	userStorage := sqlite.NewUserStorage(db)
+	taskStorage := sqlite.NewTaskStorage(db)
+	statusStorage := sqlite.NewStatusStorage(db)
+	labelStorage := sqlite.NewLabelStorage(db)
+	projectStorage := sqlite.NewProjectStorage(db)
+	workspaceStorage := sqlite.NewWorkspaceStorage(db)
+	commentStorage := sqlite.NewCommentStorage(db)
+	attachmentStorage := sqlite.NewAttachmentStorage(db)
+	taskEventStorage := sqlite.NewTaskEventStorage(db)
+	calendarStorage := sqlite.NewCalendarStorage(db)
+
+	blobStore, err := local.New(attachmentsConfig.Path)
+
+	if err != nil {
+		panic(err)
+	}
+
+	userService := user.New(log, userStorage, userStorage, userStorage, userStorage, userStorage, newMailer(log, mailConfig), userStorage, blobStore, accessTokenTTL, refreshTokenTTL, passwordResetConfig.TokenTTL, statusWorkflow.Initial)
+
+	workflow := tasks.NewWorkflow(statusWorkflow.Initial, statusWorkflow.Transitions, statusWorkflow.Closed, statusWorkflow.Completed)
+
+	err = workflow.Validate(func(name string) (bool, error) {
+		_, err := statusStorage.GetStatusByName(context.Background(), name)
+		if errors.Is(err, storage.ErrStatusNotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	})
 
 	if err != nil {
 		panic(err)
 	}
-	userService := user.New(log, userStorage, userStorage, userStorage, userStorage, accessTokenTTL, refreshTokenTTL)
 
-	tasksService := tasks.New(log, taskStorage, taskStorage, taskStorage)
+	tasksService := tasks.New(log, taskStorage, taskStorage, taskStorage, taskStorage, taskStorage, taskStorage, taskStorage, taskEventStorage, hub.New(), taskStorage, taskStorage, statusStorage, projectStorage, workspaceStorage, blobStore, workflow, watchConfig.PollInterval)
+
+	statusesService := statuses.New(log, statusStorage, statusStorage, statusStorage, statusStorage, statusWorkflow.Initial)
+
+	labelsService := labels.New(log, labelStorage, labelStorage, labelStorage)
+
+	projectsService := projects.New(log, projectStorage, projectStorage, projectStorage, projectStorage, statusStorage, statusWorkflow.Initial)
+
+	workspacesService := workspaces.New(log, workspaceStorage, workspaceStorage, workspaceStorage)
 
-	grpcApp := grpcapp.New(grpcPort, log, userService, tasksService)
+	commentsService := comments.New(log, commentStorage, commentStorage, commentStorage, commentStorage)
+
+	attachmentsService := attachments.New(log, attachmentStorage, attachmentStorage, attachmentStorage, blobStore, attachmentsConfig.MaxSize, attachmentsConfig.ContentTypes)
+
+	calendarService := calendar.New(log, calendarStorage, calendarStorage, taskStorage, workflow)
+
+	grpcApp := grpcapp.New(grpcPort, log, userService, tasksService, statusesService, labelsService, projectsService, workspacesService, commentsService, attachmentsService, calendarService, userStorage)
+
+	httpApp := httpapp.New(httpPort, log, calendarService)
+
+	scheduler := reminders.New(log, taskStorage, notifier.NewLog(log), remindersConfig.Interval, remindersConfig.BatchSize)
+
+	purger := trash.New(log, tasksService, blobStore, trashConfig.Retention, trashConfig.Interval, trashConfig.BatchSize)
 
 	return &App{
 		grpcApp,
+		httpApp,
+		scheduler,
+		purger,
 	}
 }
+
+func newMailer(log *slog.Logger, mailConfig config.MailConfig) user.Mailer {
+	switch mailConfig.Driver {
+	case "log":
+		return mailer.NewLog(log)
+	case "file":
+		fileMailer, err := mailer.NewFile(mailConfig.Path)
+
+		if err != nil {
+			panic(err)
+		}
+
+		return fileMailer
+	}
+
+	panic("unknown mail driver: " + mailConfig.Driver)
+}
@@ -5,8 +5,15 @@ import (
 	"google.golang.org/grpc"
 	"log/slog"
 	"net"
+	"server/internal/grpc/attachments"
+	"server/internal/grpc/calendar"
+	"server/internal/grpc/comments"
+	"server/internal/grpc/labels"
+	"server/internal/grpc/projects"
+	"server/internal/grpc/statuses"
 	"server/internal/grpc/tasks"
 	"server/internal/grpc/user"
+	"server/internal/grpc/workspaces"
 	"server/internal/lib/interceptors"
 )
 
@@ -16,13 +23,29 @@ type App struct {
 	port       int
 }
 
-func New(port int, log *slog.Logger, userService user.User, tasksService tasks.Tasks) *App {
-	gRPCServer := grpc.NewServer(grpc.UnaryInterceptor(interceptors.IsAuth))
+func New(port int, log *slog.Logger, userService user.User, tasksService tasks.Tasks, statusesService statuses.Statuses, labelsService labels.Labels, projectsService projects.Projects, workspacesService workspaces.Workspaces, commentsService comments.Comments, attachmentsService attachments.Attachments, calendarService calendar.Calendar, sessions interceptors.SessionChecker) *App {
+	auth := interceptors.NewAuth(sessions)
+
+	gRPCServer := grpc.NewServer(grpc.UnaryInterceptor(auth.IsAuth), grpc.StreamInterceptor(auth.IsAuthStream))
 
 	user.Register(gRPCServer, userService)
 
 	tasks.Register(gRPCServer, tasksService)
 
+	statuses.Register(gRPCServer, statusesService)
+
+	labels.Register(gRPCServer, labelsService)
+
+	projects.Register(gRPCServer, projectsService)
+
+	workspaces.Register(gRPCServer, workspacesService)
+
+	comments.Register(gRPCServer, commentsService)
+
+	attachments.Register(gRPCServer, attachmentsService)
+
+	calendar.Register(gRPCServer, calendarService)
+
 	return &App{
 		port:       port,
 		gRPCServer: gRPCServer,
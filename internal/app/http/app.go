@@ -0,0 +1,73 @@
+package httpapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"server/internal/http/calendar"
+	"time"
+)
+
+// shutdownTimeout bounds how long Stop waits for requests in flight.
+const shutdownTimeout = 10 * time.Second
+
+// App serves what plain HTTP clients need, such as calendar apps polling a
+// feed; everything else goes through gRPC.
+type App struct {
+	log        *slog.Logger
+	httpServer *http.Server
+	port       int
+}
+
+func New(port int, log *slog.Logger, calendarService calendar.Calendar) *App {
+	mux := http.NewServeMux()
+
+	calendar.Register(mux, log, calendarService)
+
+	return &App{
+		port:       port,
+		httpServer: &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second},
+		log:        log,
+	}
+}
+
+func (a *App) MustRun() {
+	if err := a.run(); err != nil {
+		panic(err)
+	}
+}
+
+func (a *App) run() error {
+	const op = "httpapp.run"
+
+	log := a.log.With(slog.String("op", op))
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", a.port))
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("starting HTTP server on port", slog.String("addr", l.Addr().String()))
+
+	if err := a.httpServer.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) Stop() {
+	const op = "httpapp.stop"
+	a.log.With(slog.String("op", op)).Info("stopping HTTP server")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := a.httpServer.Shutdown(ctx); err != nil {
+		a.log.With(slog.String("op", op)).Warn("failed to stop HTTP server", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,9 @@
+package blobstore
+
+import "errors"
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+
+	ErrInvalidKey = errors.New("invalid blob key")
+)
@@ -0,0 +1,119 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"server/internal/blobstore"
+	"strings"
+)
+
+// Store keeps blobs as files under a root directory, fanned out into
+// subdirectories by the first two characters of the key.
+type Store struct {
+	root string
+}
+
+func New(root string) (*Store, error) {
+	const op = "blobstore.local.new"
+
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Store{root: root}, nil
+}
+
+// Put writes the blob to a temporary file first, so a failed upload never
+// leaves a partial blob under the key.
+func (s *Store) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	const op = "blobstore.local.put"
+
+	path, err := s.path(key)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+
+	if err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n, nil
+}
+
+func (s *Store) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	const op = "blobstore.local.open"
+
+	path, err := s.path(key)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w", op, blobstore.ErrBlobNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return f, nil
+}
+
+// Delete removes the blob; deleting a missing blob is not an error.
+func (s *Store) Delete(_ context.Context, key string) error {
+	const op = "blobstore.local.delete"
+
+	path, err := s.path(key)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Store) path(key string) (string, error) {
+	if len(key) < 3 || strings.ContainsAny(key, `/\.`) {
+		return "", blobstore.ErrInvalidKey
+	}
+
+	return filepath.Join(s.root, key[:2], key), nil
+}
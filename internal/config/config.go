@@ -8,11 +8,19 @@ import (
 )
 
 type Config struct {
-	Env             string        `yaml:"env" env-default:"local"`
-	StoragePath     string        `yaml:"storage_path" env-required:"true"`
-	AccessTokenTTL  time.Duration `yaml:"access_token_ttl" env-required:"true"`
-	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl" env-required:"true"`
-	GRPC            GRPCConfig    `yaml:"grpc"`
+	Env             string               `yaml:"env" env-default:"local"`
+	StoragePath     string               `yaml:"storage_path" env-required:"true"`
+	AccessTokenTTL  time.Duration        `yaml:"access_token_ttl" env-required:"true"`
+	RefreshTokenTTL time.Duration        `yaml:"refresh_token_ttl" env-required:"true"`
+	PasswordReset   PasswordResetConfig  `yaml:"password_reset"`
+	Mail            MailConfig           `yaml:"mail"`
+	GRPC            GRPCConfig           `yaml:"grpc"`
+	HTTP            HTTPConfig           `yaml:"http"`
+	StatusWorkflow  StatusWorkflowConfig `yaml:"status_workflow"`
+	Reminders       RemindersConfig      `yaml:"reminders"`
+	Attachments     AttachmentsConfig    `yaml:"attachments"`
+	Trash           TrashConfig          `yaml:"trash"`
+	Watch           WatchConfig          `yaml:"watch"`
 }
 
 type GRPCConfig struct {
@@ -20,6 +28,57 @@ type GRPCConfig struct {
 	Timeout time.Duration `yaml:"timeout"`
 }
 
+// PasswordResetConfig sets how long a password reset token stays usable.
+type PasswordResetConfig struct {
+	TokenTTL time.Duration `yaml:"token_ttl" env-default:"1h"`
+}
+
+// MailConfig chooses how mail reaches users: "log" writes it to the
+// application log, "file" into a file per message under Path. Only the local
+// env may leave Driver out, and gets "log" then.
+type MailConfig struct {
+	Driver string `yaml:"driver"`
+	Path   string `yaml:"path" env-default:"./storage/mail"`
+}
+
+// HTTPConfig is the HTTP server of the calendar feeds.
+type HTTPConfig struct {
+	Port int `yaml:"port" env-default:"8080"`
+}
+
+type StatusWorkflowConfig struct {
+	Initial     string              `yaml:"initial" env-default:"pending"`
+	Transitions map[string][]string `yaml:"transitions"`
+	Closed      []string            `yaml:"closed"`
+	Completed   string              `yaml:"completed" env-default:"complete"`
+}
+
+type RemindersConfig struct {
+	Interval  time.Duration `yaml:"interval" env-default:"1m"`
+	BatchSize int           `yaml:"batch_size" env-default:"100"`
+}
+
+type AttachmentsConfig struct {
+	Path    string `yaml:"path" env-default:"./storage/attachments"`
+	MaxSize int64  `yaml:"max_size" env-default:"10485760"`
+	// ContentTypes lists the accepted media types; "image/*" matches a whole family.
+	ContentTypes []string `yaml:"content_types"`
+}
+
+// TrashConfig sets how long removed tasks stay restorable and how often the
+// expired ones are purged.
+type TrashConfig struct {
+	Retention time.Duration `yaml:"retention" env-default:"720h"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+	BatchSize int           `yaml:"batch_size" env-default:"100"`
+}
+
+// WatchConfig sets how often a task watcher reads the history for the events
+// the hub does not carry; zero leaves the watcher to the hub alone.
+type WatchConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval" env-default:"1m"`
+}
+
 func MustLoad() *Config {
 	path := fetchConfigPath()
 
@@ -43,6 +102,15 @@ func MustLoadByPath(configPath string) *Config {
 		panic("failed to read config: " + err.Error())
 	}
 
+	// A password reset mailed to the log never reaches the user.
+	if config.Mail.Driver == "" {
+		if config.Env != "local" {
+			panic("mail driver is required in the " + config.Env + " env")
+		}
+
+		config.Mail.Driver = "log"
+	}
+
 	return &config
 }
 
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type Attachment struct {
+	ID          int64     `json:"id"`
+	TaskID      int64     `json:"task_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	BlobKey     string    `json:"-"`
+	Uploader    TodosUser `json:"uploader"`
+	CreatedAt   time.Time `json:"created_at"`
+}
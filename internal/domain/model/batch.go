@@ -0,0 +1,42 @@
+package model
+
+type TaskBatchAction int
+
+const (
+	TaskBatchUnspecified TaskBatchAction = iota
+	TaskBatchCreate
+	TaskBatchUpdate
+	TaskBatchChangeStatus
+	TaskBatchDelete
+)
+
+// TaskBatchOperation is one item of a batch. Create uses Task, Update uses
+// Update, ChangeStatus uses StatusID and Delete uses Cascade; the others take
+// the task by TaskID.
+type TaskBatchOperation struct {
+	Action   TaskBatchAction `json:"action"`
+	TaskID   int64           `json:"task_id"`
+	Task     RequestTask     `json:"task"`
+	Update   UpdateTask      `json:"update"`
+	StatusID int64           `json:"status_id"`
+	Cascade  bool            `json:"cascade"`
+}
+
+type TaskBatchStatus int
+
+const (
+	TaskBatchStatusUnspecified TaskBatchStatus = iota
+	TaskBatchApplied
+	TaskBatchFailed
+	// TaskBatchAborted means the operation was rolled back or never run
+	// because another one of an atomic batch failed.
+	TaskBatchAborted
+)
+
+type TaskBatchResult struct {
+	Status TaskBatchStatus `json:"status"`
+	TaskID int64           `json:"task_id"`
+	// Task is the task after a create, update or status change.
+	Task *Task `json:"task"`
+	Err  error `json:"-"`
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// CalendarFeed is the link to the iCalendar feed of a user's tasks. The token
+// is known only when the feed is created; the server keeps its hash.
+type CalendarFeed struct {
+	Token     string    `json:"token"`
+	Path      string    `json:"path"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package model
+
+type ChecklistItem struct {
+	ID       int64  `json:"id"`
+	TaskID   int64  `json:"task_id"`
+	Text     string `json:"text"`
+	Done     bool   `json:"done"`
+	Position int64  `json:"position"`
+}
@@ -0,0 +1,62 @@
+package model
+
+import "time"
+
+type TaskEventKind string
+
+const (
+	TaskCreated       TaskEventKind = "created"
+	TaskUpdated       TaskEventKind = "updated"
+	TaskStatusChanged TaskEventKind = "status_changed"
+	TaskMoved         TaskEventKind = "moved"
+	TaskAssigned      TaskEventKind = "assigned"
+	TaskUnassigned    TaskEventKind = "unassigned"
+	TaskLabeled       TaskEventKind = "labeled"
+	TaskUnlabeled     TaskEventKind = "unlabeled"
+	TaskChecklist     TaskEventKind = "checklist_changed"
+	TaskDeleted       TaskEventKind = "deleted"
+	TaskRestored      TaskEventKind = "restored"
+	TaskPurged        TaskEventKind = "purged"
+)
+
+// TaskEvent is an entry of the task history: who did what to the task and
+// which fields it changed.
+type TaskEvent struct {
+	ID        int64         `json:"id"`
+	TaskID    int64         `json:"task_id"`
+	Actor     TodosUser     `json:"actor"`
+	Kind      TaskEventKind `json:"kind"`
+	Changes   []FieldChange `json:"changes"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// FieldChange holds a task field before and after the change, formatted as
+// text; an empty value means the field was not set.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type TaskEventCursor struct {
+	ID int64 `json:"id"`
+}
+
+type TaskChangeKind int
+
+const (
+	TaskChangeUnspecified TaskChangeKind = iota
+	TaskChangeCreated
+	TaskChangeUpdated
+	TaskChangeDeleted
+)
+
+// TaskChange is what a watcher receives: the task as it is now, or only its
+// id once it is gone. Sequence is the id of the history entry behind the
+// change and lets a watcher resume where it stopped.
+type TaskChange struct {
+	Sequence int64          `json:"sequence"`
+	Kind     TaskChangeKind `json:"kind"`
+	TaskID   int64          `json:"task_id"`
+	Task     *Task          `json:"task"`
+}
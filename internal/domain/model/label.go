@@ -0,0 +1,8 @@
+package model
+
+type Label struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+	UserID int64  `json:"user_id"`
+}
@@ -0,0 +1,8 @@
+package model
+
+// Mail is a plain-text message to a user; To is the user's login.
+type Mail struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type Project struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UserID      int64     `json:"user_id"`
+	Statuses    []Status  `json:"statuses"`
+}
+
+// UpdateProject holds the fields to change; nil fields are left untouched.
+type UpdateProject struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Recurrence makes a task repeat: completing it creates the next occurrence.
+// Rule is an iCalendar RRULE evaluated in Timezone from Start, the due date
+// of the first task of the series. An empty Rule means the task does not repeat.
+type Recurrence struct {
+	Rule     string     `json:"rule"`
+	Timezone string     `json:"timezone"`
+	Start    *time.Time `json:"start"`
+}
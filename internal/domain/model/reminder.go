@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type Reminder struct {
+	TaskID   int64      `json:"task_id"`
+	UserID   int64      `json:"user_id"`
+	Title    string     `json:"title"`
+	DueAt    *time.Time `json:"due_at"`
+	RemindAt time.Time  `json:"remind_at"`
+	// Attempts counts the deliveries that failed so far.
+	Attempts int `json:"attempts"`
+}
@@ -1,6 +1,8 @@
 package model
 
 type Status struct {
-	ID     int64  `json:"id"`
-	Status string `json:"status"`
+	ID       int64  `json:"id"`
+	Status   string `json:"status"`
+	UserID   int64  `json:"user_id"`
+	Position int64  `json:"position"`
 }
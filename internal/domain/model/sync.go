@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+type SyncToken struct {
+	Revision int64 `json:"revision"`
+}
+
+// TaskVersion is what conflict resolution needs to know about a stored task:
+// its revision and when each synced field was last written.
+type TaskVersion struct {
+	Revision   int64                `json:"revision"`
+	FieldTimes map[string]time.Time `json:"field_times"`
+	CreatedAt  time.Time            `json:"created_at"`
+}
+
+// TaskSyncChange is a change a client made while offline. A zero TaskID
+// creates a task; ClientID lets the client match it with the result, and the
+// same create sent again gets the task created the first time.
+type TaskSyncChange struct {
+	ClientID     string     `json:"client_id"`
+	TaskID       int64      `json:"task_id"`
+	BaseRevision int64      `json:"base_revision"`
+	ChangedAt    time.Time  `json:"changed_at"`
+	Deleted      bool       `json:"deleted"`
+	Update       UpdateTask `json:"update"`
+}
+
+type TaskSyncStatus int
+
+const (
+	TaskSyncUnspecified TaskSyncStatus = iota
+	// TaskSyncApplied means every field of the change was written.
+	TaskSyncApplied
+	// TaskSyncMerged means some fields lost to newer writes on the server.
+	TaskSyncMerged
+	TaskSyncRejected
+)
+
+type TaskSyncResult struct {
+	ClientID string         `json:"client_id"`
+	TaskID   int64          `json:"task_id"`
+	Status   TaskSyncStatus `json:"status"`
+	// Overridden lists the fields where the server copy was kept.
+	Overridden []string `json:"overridden"`
+	Error      string   `json:"error"`
+}
+
+// TaskDelta holds the changes after a sync token: the tasks changed since,
+// the ids of the deleted ones and the token to pass next time.
+type TaskDelta struct {
+	Tasks     []Task  `json:"tasks"`
+	Deleted   []int64 `json:"deleted"`
+	SyncToken string  `json:"sync_token"`
+	HasMore   bool    `json:"has_more"`
+}
+
+// TaskChanges is a page of the stored changes after a revision; Tasks
+// include the ones in the trash.
+type TaskChanges struct {
+	Tasks    []Task  `json:"tasks"`
+	Purged   []int64 `json:"purged"`
+	Revision int64   `json:"revision"`
+	HasMore  bool    `json:"has_more"`
+}
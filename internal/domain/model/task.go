@@ -2,19 +2,113 @@ package model
 
 import "time"
 
+type Priority int
+
+const (
+	PriorityUnspecified Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
 type RequestTask struct {
-	Title     string    `json:"title"`
-	Body      string    `json:"body"`
-	CreatedAt time.Time `json:"created_at"`
-	UserID    int64     `json:"user_id"`
-	StatusID  int64     `json:"status_id"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UserID      int64      `json:"user_id"`
+	CreatorID   int64      `json:"creator_id"`
+	StatusID    int64      `json:"status_id"`
+	DueAt       *time.Time `json:"due_at"`
+	RemindAt    *time.Time `json:"remind_at"`
+	Priority    Priority   `json:"priority"`
+	ParentID    int64      `json:"parent_id"`
+	ProjectID   int64      `json:"project_id"`
+	WorkspaceID int64      `json:"workspace_id"`
+	Recurrence  Recurrence `json:"recurrence"`
 }
 
 type Task struct {
+	ID           int64       `json:"id"`
+	Title        string      `json:"title"`
+	Body         string      `json:"body"`
+	CreatedAt    time.Time   `json:"created_at"`
+	DueAt        *time.Time  `json:"due_at"`
+	RemindAt     *time.Time  `json:"remind_at"`
+	Priority     Priority    `json:"priority"`
+	User         TodosUser   `json:"user"`
+	Creator      TodosUser   `json:"creator"`
+	Assignees    []TodosUser `json:"assignees"`
+	Status       Status      `json:"status"`
+	Labels       []Label     `json:"labels"`
+	ParentID     int64       `json:"parent_id"`
+	ProjectID    int64       `json:"project_id"`
+	WorkspaceID  int64       `json:"workspace_id"`
+	CommentCount int64       `json:"comment_count"`
+	Recurrence   Recurrence  `json:"recurrence"`
+	// DeletedAt is set while the task is in the trash.
+	DeletedAt *time.Time `json:"deleted_at"`
+	// Revision grows with every change of the task.
+	Revision int64 `json:"revision"`
+
+	Subtasks  []Task          `json:"subtasks"`
+	Checklist []ChecklistItem `json:"checklist"`
+	// Progress is the share of done checklist items and closed subtasks, in percent.
+	Progress int `json:"progress"`
+}
+
+// UpdateTask holds the fields to change; nil fields are left untouched.
+// A zero time in DueAt or RemindAt clears the stored value.
+type UpdateTask struct {
+	Title    *string    `json:"title"`
+	Body     *string    `json:"body"`
+	StatusID *int64     `json:"status_id"`
+	DueAt    *time.Time `json:"due_at"`
+	RemindAt *time.Time `json:"remind_at"`
+	Priority *Priority  `json:"priority"`
+	// Recurrence replaces the whole recurrence; an empty Rule stops the series.
+	Recurrence *Recurrence `json:"recurrence"`
+	// UpdatedAt is when the change was made; it stamps the synced fields it touches.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TaskSort int
+
+const (
+	TaskSortCreatedAtDesc TaskSort = iota
+	TaskSortCreatedAtAsc
+	TaskSortTitleAsc
+	TaskSortTitleDesc
+)
+
+// TaskCursor is where a page of tasks ends. Filter fingerprints the filter the
+// page was listed with, so the cursor is not used with another one.
+type TaskCursor struct {
+	Sort      TaskSort  `json:"sort"`
+	Filter    string    `json:"filter,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	Title     string    `json:"title,omitempty"`
 	ID        int64     `json:"id"`
-	Title     string    `json:"title"`
-	Body      string    `json:"body"`
-	CreatedAt time.Time `json:"created_at"`
-	User      TodosUser `json:"user"`
-	Status    Status    `json:"status"`
+}
+
+type TaskFilter struct {
+	UserID        int64
+	ProjectID     int64
+	WorkspaceID   int64
+	AssignedToMe  bool
+	StatusIDs     []int64
+	LabelIDs      []int64
+	Priorities    []Priority
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Sort          TaskSort
+	Limit         int
+	After         *TaskCursor
+}
+
+type TaskSearchResult struct {
+	Task           Task    `json:"task"`
+	TitleHighlight string  `json:"title_highlight"`
+	BodySnippet    string  `json:"body_snippet"`
+	Rank           float64 `json:"rank"`
 }
@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+type TaskFileFormat int
+
+const (
+	TaskFileUnspecified TaskFileFormat = iota
+	TaskFileJSONLines
+	TaskFileCSV
+	// TaskFileTodoistCSV is the CSV Todoist exports projects in; it can only
+	// be imported.
+	TaskFileTodoistCSV
+)
+
+// TaskRecord is a task as it is written to and read from a file. ID and
+// ParentID only tie the rows of one file together; Row is the line the
+// record starts on.
+type TaskRecord struct {
+	Row                int        `json:"-"`
+	ID                 int64      `json:"id,omitempty"`
+	ParentID           int64      `json:"parent_id,omitempty"`
+	Title              string     `json:"title"`
+	Body               string     `json:"body,omitempty"`
+	Status             string     `json:"status,omitempty"`
+	Priority           Priority   `json:"-"`
+	DueAt              *time.Time `json:"due_at,omitempty"`
+	RemindAt           *time.Time `json:"remind_at,omitempty"`
+	Recurrence         string     `json:"recurrence,omitempty"`
+	RecurrenceTimezone string     `json:"recurrence_timezone,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+}
+
+// TaskImportOptions tell where imported tasks go and whether the import is
+// only tried out.
+type TaskImportOptions struct {
+	Format      TaskFileFormat `json:"format"`
+	ProjectID   int64          `json:"project_id"`
+	WorkspaceID int64          `json:"workspace_id"`
+	DryRun      bool           `json:"dry_run"`
+}
+
+type TaskImportError struct {
+	Row int   `json:"row"`
+	Err error `json:"-"`
+}
+
+// TaskImportReport tells how an import went. Errors holds the first of the
+// failed rows; Failed counts all of them.
+type TaskImportReport struct {
+	Rows     int               `json:"rows"`
+	Imported int               `json:"imported"`
+	Failed   int               `json:"failed"`
+	Errors   []TaskImportError `json:"errors"`
+	DryRun   bool              `json:"dry_run"`
+}
@@ -12,3 +12,9 @@ type TodosUser struct {
 	Name  string `db:"name"`
 	Login string `db:"login"`
 }
+
+// UpdateUser holds the profile fields to change; nil fields are left untouched.
+type UpdateUser struct {
+	Name  *string `json:"name"`
+	Login *string `json:"login"`
+}
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+	RoleViewer Role = "viewer"
+)
+
+// CanWrite reports whether the role may create and change workspace tasks.
+func (r Role) CanWrite() bool {
+	return r == RoleOwner || r == RoleAdmin || r == RoleMember
+}
+
+// CanManage reports whether the role may invite, remove and re-role members.
+func (r Role) CanManage() bool {
+	return r == RoleOwner || r == RoleAdmin
+}
+
+type Workspace struct {
+	ID        int64             `json:"id"`
+	Name      string            `json:"name"`
+	CreatedAt time.Time         `json:"created_at"`
+	OwnerID   int64             `json:"owner_id"`
+	Role      Role              `json:"role"`
+	Members   []WorkspaceMember `json:"members"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID int64     `json:"workspace_id"`
+	User        TodosUser `json:"user"`
+	Role        Role      `json:"role"`
+}
@@ -0,0 +1,193 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"server/internal/domain/model"
+	"server/internal/lib/mapper"
+	"server/internal/services/attachments"
+	attachmentrpc "server/pkg/attachment"
+)
+
+// chunkSize is the size of the chunks a download is sent in.
+const chunkSize = 64 * 1024
+
+type serverApi struct {
+	attachmentrpc.UnimplementedAttachmentServer
+	attachments Attachments
+}
+
+func Register(gRPC *grpc.Server, attachments Attachments) {
+	attachmentrpc.RegisterAttachmentServer(gRPC, &serverApi{attachments: attachments})
+}
+
+type Attachments interface {
+	UploadAttachment(ctx context.Context, attachment model.Attachment, r io.Reader) (model.Attachment, error)
+	DownloadAttachment(ctx context.Context, attachmentID int64) (model.Attachment, io.ReadCloser, error)
+	FetchAttachments(ctx context.Context, taskID int64) ([]model.Attachment, error)
+	DeleteAttachment(ctx context.Context, attachmentID int64) error
+}
+
+func (s *serverApi) UploadAttachment(stream attachmentrpc.Attachment_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "attachment info is required")
+		}
+		return err
+	}
+
+	info := first.GetInfo()
+
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry the attachment info")
+	}
+
+	if info.GetTaskId() == 0 {
+		return status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if info.GetFileName() == "" {
+		return status.Error(codes.InvalidArgument, "file name is required")
+	}
+
+	// The declared content type is ignored, the service sniffs it from the file.
+	attachment, err := s.attachments.UploadAttachment(stream.Context(), model.Attachment{
+		TaskID:   info.GetTaskId(),
+		FileName: info.GetFileName(),
+	}, &chunkReader{stream: stream})
+
+	if err != nil {
+		return attachmentError(err)
+	}
+
+	return stream.SendAndClose(mapper.ToAttachmentResponse(attachment))
+}
+
+func (s *serverApi) DownloadAttachment(request *attachmentrpc.DownloadAttachmentRequest, stream attachmentrpc.Attachment_DownloadAttachmentServer) error {
+	if request.GetAttachmentId() == 0 {
+		return status.Error(codes.InvalidArgument, "attachment id is required")
+	}
+
+	attachment, r, err := s.attachments.DownloadAttachment(stream.Context(), request.GetAttachmentId())
+
+	if err != nil {
+		return attachmentError(err)
+	}
+	defer r.Close()
+
+	info := &attachmentrpc.DownloadAttachmentResponse{
+		Data: &attachmentrpc.DownloadAttachmentResponse_Info{Info: mapper.ToAttachmentResponse(attachment)},
+	}
+
+	if err := stream.Send(info); err != nil {
+		return err
+	}
+
+	buf := make([]byte, chunkSize)
+
+	for {
+		n, err := r.Read(buf)
+
+		if n > 0 {
+			chunk := &attachmentrpc.DownloadAttachmentResponse{
+				Data: &attachmentrpc.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			}
+
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return status.Error(codes.Internal, "internal server error")
+		}
+	}
+}
+
+func (s *serverApi) ListAttachments(ctx context.Context, request *attachmentrpc.ListAttachmentsRequest) (*attachmentrpc.ListAttachmentsResponse, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	list, err := s.attachments.FetchAttachments(ctx, request.GetTaskId())
+
+	if err != nil {
+		return nil, attachmentError(err)
+	}
+
+	return &attachmentrpc.ListAttachmentsResponse{Attachments: mapper.ToAttachmentsResponse(list)}, nil
+}
+
+func (s *serverApi) DeleteAttachment(ctx context.Context, request *attachmentrpc.DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	if request.GetAttachmentId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attachment id is required")
+	}
+
+	if err := s.attachments.DeleteAttachment(ctx, request.GetAttachmentId()); err != nil {
+		return nil, attachmentError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// chunkReader turns the chunks of an upload stream into an io.Reader.
+type chunkReader struct {
+	stream attachmentrpc.Attachment_UploadAttachmentServer
+	buf    []byte
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		request, err := c.stream.Recv()
+
+		if err != nil {
+			return 0, err
+		}
+
+		if request.GetInfo() != nil {
+			return 0, status.Error(codes.InvalidArgument, "attachment info must be sent only once")
+		}
+
+		c.buf = request.GetChunk()
+	}
+
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+
+	return n, nil
+}
+
+func attachmentError(err error) error {
+	// Failures of the upload stream itself already carry a status.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, attachments.ErrAttachmentNotFound):
+		return status.Error(codes.NotFound, "attachment not found")
+	case errors.Is(err, attachments.ErrAttachmentTooLarge):
+		return status.Error(codes.InvalidArgument, "attachment is too large")
+	case errors.Is(err, attachments.ErrContentTypeNotAllowed):
+		return status.Error(codes.InvalidArgument, "content type is not allowed")
+	case errors.Is(err, attachments.ErrInvalidFileName):
+		return status.Error(codes.InvalidArgument, "invalid file name")
+	case errors.Is(err, attachments.ErrTaskNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	case errors.Is(err, attachments.ErrTaskAccessDenied):
+		return status.Error(codes.PermissionDenied, "access to the task is denied")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
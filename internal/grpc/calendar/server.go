@@ -0,0 +1,71 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"server/internal/domain/model"
+	"server/internal/services/calendar"
+	calendarrpc "server/pkg/calendar"
+)
+
+const contentType = "text/calendar; charset=utf-8"
+
+type serverApi struct {
+	calendarrpc.UnimplementedCalendarServer
+	calendar Calendar
+}
+
+func Register(gRPC *grpc.Server, calendar Calendar) {
+	calendarrpc.RegisterCalendarServer(gRPC, &serverApi{calendar: calendar})
+}
+
+type Calendar interface {
+	CreateFeed(ctx context.Context) (model.CalendarFeed, error)
+	RevokeFeed(ctx context.Context) error
+	ExportCalendar(ctx context.Context) ([]byte, error)
+}
+
+func (s *serverApi) CreateCalendarFeed(ctx context.Context, _ *emptypb.Empty) (*calendarrpc.CreateCalendarFeedResponse, error) {
+	feed, err := s.calendar.CreateFeed(ctx)
+
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &calendarrpc.CreateCalendarFeedResponse{
+		Token:     feed.Token,
+		Path:      feed.Path,
+		CreatedAt: timestamppb.New(feed.CreatedAt),
+	}, nil
+}
+
+func (s *serverApi) RevokeCalendarFeed(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.calendar.RevokeFeed(ctx); err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) ExportCalendar(ctx context.Context, _ *emptypb.Empty) (*calendarrpc.ExportCalendarResponse, error) {
+	data, err := s.calendar.ExportCalendar(ctx)
+
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &calendarrpc.ExportCalendarResponse{Ics: data, ContentType: contentType}, nil
+}
+
+func calendarError(err error) error {
+	if errors.Is(err, calendar.ErrFeedNotFound) {
+		return status.Error(codes.NotFound, "calendar feed not found")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
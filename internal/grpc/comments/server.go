@@ -0,0 +1,114 @@
+package comments
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"server/internal/domain/model"
+	"server/internal/lib/mapper"
+	"server/internal/services/comments"
+	commentrpc "server/pkg/comment"
+	"strings"
+)
+
+type serverApi struct {
+	commentrpc.UnimplementedCommentServer
+	comments Comments
+}
+
+func Register(gRPC *grpc.Server, comments Comments) {
+	commentrpc.RegisterCommentServer(gRPC, &serverApi{comments: comments})
+}
+
+type Comments interface {
+	AddComment(ctx context.Context, taskID int64, body string) (model.Comment, error)
+	FetchComments(ctx context.Context, taskID int64, pageSize int, pageToken string) ([]model.Comment, string, error)
+	EditComment(ctx context.Context, commentID int64, body string) (model.Comment, error)
+	DeleteComment(ctx context.Context, commentID int64) error
+}
+
+func (s *serverApi) AddComment(ctx context.Context, request *commentrpc.AddCommentRequest) (*commentrpc.CommentData, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if strings.TrimSpace(request.GetBody()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "body is required")
+	}
+
+	comment, err := s.comments.AddComment(ctx, request.GetTaskId(), request.GetBody())
+
+	if err != nil {
+		return nil, commentError(err)
+	}
+
+	return mapper.ToCommentResponse(comment), nil
+}
+
+func (s *serverApi) EditComment(ctx context.Context, request *commentrpc.EditCommentRequest) (*commentrpc.CommentData, error) {
+	if request.GetCommentId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "comment id is required")
+	}
+
+	if strings.TrimSpace(request.GetBody()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "body is required")
+	}
+
+	comment, err := s.comments.EditComment(ctx, request.GetCommentId(), request.GetBody())
+
+	if err != nil {
+		return nil, commentError(err)
+	}
+
+	return mapper.ToCommentResponse(comment), nil
+}
+
+func (s *serverApi) DeleteComment(ctx context.Context, request *commentrpc.DeleteCommentRequest) (*emptypb.Empty, error) {
+	if request.GetCommentId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "comment id is required")
+	}
+
+	if err := s.comments.DeleteComment(ctx, request.GetCommentId()); err != nil {
+		return nil, commentError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) ListComments(ctx context.Context, request *commentrpc.ListCommentsRequest) (*commentrpc.ListCommentsResponse, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if request.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	list, nextPageToken, err := s.comments.FetchComments(ctx, request.GetTaskId(), int(request.GetPageSize()), request.GetPageToken())
+
+	if err != nil {
+		return nil, commentError(err)
+	}
+
+	return &commentrpc.ListCommentsResponse{Comments: mapper.ToCommentsResponse(list), NextPageToken: nextPageToken}, nil
+}
+
+func commentError(err error) error {
+	switch {
+	case errors.Is(err, comments.ErrCommentNotFound):
+		return status.Error(codes.NotFound, "comment not found")
+	case errors.Is(err, comments.ErrCommentAccessDenied):
+		return status.Error(codes.PermissionDenied, "comment belongs to another user")
+	case errors.Is(err, comments.ErrTaskNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	case errors.Is(err, comments.ErrTaskAccessDenied):
+		return status.Error(codes.PermissionDenied, "access to the task is denied")
+	case errors.Is(err, comments.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
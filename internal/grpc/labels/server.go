@@ -0,0 +1,105 @@
+package labels
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"server/internal/domain/model"
+	"server/internal/lib/mapper"
+	"server/internal/services/labels"
+	labelrpc "server/pkg/label"
+)
+
+type serverApi struct {
+	labelrpc.UnimplementedLabelServer
+	labels Labels
+}
+
+func Register(gRPC *grpc.Server, labels Labels) {
+	labelrpc.RegisterLabelServer(gRPC, &serverApi{labels: labels})
+}
+
+type Labels interface {
+	CreateLabel(ctx context.Context, name string, color string) (int64, error)
+	FetchLabels(ctx context.Context) ([]model.Label, error)
+	AttachLabel(ctx context.Context, taskID int64, labelID int64) error
+	DetachLabel(ctx context.Context, taskID int64, labelID int64) error
+}
+
+func (s *serverApi) CreateLabel(ctx context.Context, request *labelrpc.CreateLabelRequest) (*labelrpc.CreateLabelResponse, error) {
+	if request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	id, err := s.labels.CreateLabel(ctx, request.GetName(), request.GetColor())
+
+	if err != nil {
+		return nil, labelError(err)
+	}
+
+	return &labelrpc.CreateLabelResponse{LabelId: id}, nil
+}
+
+func (s *serverApi) ListLabels(ctx context.Context, _ *emptypb.Empty) (*labelrpc.ListLabelsResponse, error) {
+	list, err := s.labels.FetchLabels(ctx)
+
+	if err != nil {
+		return nil, labelError(err)
+	}
+
+	return &labelrpc.ListLabelsResponse{Labels: mapper.ToLabelsResponse(list)}, nil
+}
+
+func (s *serverApi) AttachLabel(ctx context.Context, request *labelrpc.TaskLabelRequest) (*emptypb.Empty, error) {
+	if err := validateTaskLabelRequest(request); err != nil {
+		return nil, err
+	}
+
+	if err := s.labels.AttachLabel(ctx, request.GetTaskId(), request.GetLabelId()); err != nil {
+		return nil, labelError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) DetachLabel(ctx context.Context, request *labelrpc.TaskLabelRequest) (*emptypb.Empty, error) {
+	if err := validateTaskLabelRequest(request); err != nil {
+		return nil, err
+	}
+
+	if err := s.labels.DetachLabel(ctx, request.GetTaskId(), request.GetLabelId()); err != nil {
+		return nil, labelError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateTaskLabelRequest(request *labelrpc.TaskLabelRequest) error {
+	if request.GetTaskId() == 0 {
+		return status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if request.GetLabelId() == 0 {
+		return status.Error(codes.InvalidArgument, "label id is required")
+	}
+
+	return nil
+}
+
+func labelError(err error) error {
+	switch {
+	case errors.Is(err, labels.ErrLabelNotFound):
+		return status.Error(codes.NotFound, "label not found")
+	case errors.Is(err, labels.ErrLabelExists):
+		return status.Error(codes.AlreadyExists, "label already exists")
+	case errors.Is(err, labels.ErrTaskNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	case errors.Is(err, labels.ErrTaskAccessDenied):
+		return status.Error(codes.PermissionDenied, "access to the task is denied")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
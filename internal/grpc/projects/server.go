@@ -0,0 +1,144 @@
+package projects
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"server/internal/domain/model"
+	"server/internal/lib/mapper"
+	"server/internal/services/projects"
+	projectrpc "server/pkg/project"
+)
+
+type serverApi struct {
+	projectrpc.UnimplementedProjectServer
+	projects Projects
+}
+
+func Register(gRPC *grpc.Server, projects Projects) {
+	projectrpc.RegisterProjectServer(gRPC, &serverApi{projects: projects})
+}
+
+type Projects interface {
+	CreateProject(ctx context.Context, name string, description string, statusIDs []int64) (int64, error)
+	FetchProject(ctx context.Context, projectID int64) (model.Project, error)
+	FetchProjects(ctx context.Context) ([]model.Project, error)
+	UpdateProject(ctx context.Context, projectID int64, project model.UpdateProject) (model.Project, error)
+	RemoveProject(ctx context.Context, projectID int64) error
+}
+
+func (s *serverApi) CreateProject(ctx context.Context, request *projectrpc.CreateProjectRequest) (*projectrpc.CreateProjectResponse, error) {
+	if request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	id, err := s.projects.CreateProject(ctx, request.GetName(), request.GetDescription(), request.GetStatusIds())
+
+	if err != nil {
+		return nil, projectError(err)
+	}
+
+	return &projectrpc.CreateProjectResponse{ProjectId: id}, nil
+}
+
+func (s *serverApi) GetProject(ctx context.Context, request *projectrpc.GetProjectRequest) (*projectrpc.ProjectData, error) {
+	if request.GetProjectId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "project id is required")
+	}
+
+	project, err := s.projects.FetchProject(ctx, request.GetProjectId())
+
+	if err != nil {
+		return nil, projectError(err)
+	}
+
+	return mapper.ToProjectResponse(project), nil
+}
+
+func (s *serverApi) ListProjects(ctx context.Context, _ *emptypb.Empty) (*projectrpc.ListProjectsResponse, error) {
+	list, err := s.projects.FetchProjects(ctx)
+
+	if err != nil {
+		return nil, projectError(err)
+	}
+
+	return &projectrpc.ListProjectsResponse{Projects: mapper.ToProjectsResponse(list)}, nil
+}
+
+func (s *serverApi) UpdateProject(ctx context.Context, request *projectrpc.UpdateProjectRequest) (*projectrpc.ProjectData, error) {
+	update, err := validateUpdateProjectRequest(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projects.UpdateProject(ctx, request.GetProjectId(), update)
+
+	if err != nil {
+		return nil, projectError(err)
+	}
+
+	return mapper.ToProjectResponse(project), nil
+}
+
+func (s *serverApi) DeleteProject(ctx context.Context, request *projectrpc.DeleteProjectRequest) (*emptypb.Empty, error) {
+	if request.GetProjectId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "project id is required")
+	}
+
+	if err := s.projects.RemoveProject(ctx, request.GetProjectId()); err != nil {
+		return nil, projectError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateUpdateProjectRequest(request *projectrpc.UpdateProjectRequest) (model.UpdateProject, error) {
+	var update model.UpdateProject
+
+	if request.GetProjectId() == 0 {
+		return update, status.Error(codes.InvalidArgument, "project id is required")
+	}
+
+	paths := request.GetUpdateMask().GetPaths()
+
+	if len(paths) == 0 {
+		return update, status.Error(codes.InvalidArgument, "update mask is required")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if request.GetName() == "" {
+				return update, status.Error(codes.InvalidArgument, "name is required")
+			}
+			name := request.GetName()
+			update.Name = &name
+		case "description":
+			description := request.GetDescription()
+			update.Description = &description
+		default:
+			return update, status.Errorf(codes.InvalidArgument, "unknown update mask path %q", path)
+		}
+	}
+
+	return update, nil
+}
+
+func projectError(err error) error {
+	switch {
+	case errors.Is(err, projects.ErrProjectNotFound):
+		return status.Error(codes.NotFound, "project not found")
+	case errors.Is(err, projects.ErrProjectExists):
+		return status.Error(codes.AlreadyExists, "project already exists")
+	case errors.Is(err, projects.ErrProjectAccessDenied):
+		return status.Error(codes.PermissionDenied, "project belongs to another user")
+	case errors.Is(err, projects.ErrStatusNotFound):
+		return status.Error(codes.InvalidArgument, "status not found")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -0,0 +1,116 @@
+package statuses
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"server/internal/domain/model"
+	"server/internal/lib/mapper"
+	"server/internal/services/statuses"
+	statusrpc "server/pkg/status"
+)
+
+type serverApi struct {
+	statusrpc.UnimplementedStatusServer
+	statuses Statuses
+}
+
+func Register(gRPC *grpc.Server, statuses Statuses) {
+	statusrpc.RegisterStatusServer(gRPC, &serverApi{statuses: statuses})
+}
+
+type Statuses interface {
+	CreateStatus(ctx context.Context, name string) (int64, error)
+	FetchStatuses(ctx context.Context) ([]model.Status, error)
+	RenameStatus(ctx context.Context, statusID int64, name string) (model.Status, error)
+	ReorderStatuses(ctx context.Context, statusIDs []int64) ([]model.Status, error)
+	RemoveStatus(ctx context.Context, statusID int64, fallbackStatusID int64) error
+}
+
+func (s *serverApi) CreateStatus(ctx context.Context, request *statusrpc.CreateStatusRequest) (*statusrpc.CreateStatusResponse, error) {
+	if request.GetStatus() == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	id, err := s.statuses.CreateStatus(ctx, request.GetStatus())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &statusrpc.CreateStatusResponse{StatusId: id}, nil
+}
+
+func (s *serverApi) ListStatuses(ctx context.Context, _ *emptypb.Empty) (*statusrpc.ListStatusesResponse, error) {
+	list, err := s.statuses.FetchStatuses(ctx)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &statusrpc.ListStatusesResponse{Statuses: mapper.ToStatusesResponse(list)}, nil
+}
+
+func (s *serverApi) RenameStatus(ctx context.Context, request *statusrpc.RenameStatusRequest) (*statusrpc.StatusData, error) {
+	if request.GetStatusId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "status id is required")
+	}
+
+	if request.GetStatus() == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	renamed, err := s.statuses.RenameStatus(ctx, request.GetStatusId(), request.GetStatus())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return mapper.ToStatusResponse(renamed), nil
+}
+
+func (s *serverApi) ReorderStatuses(ctx context.Context, request *statusrpc.ReorderStatusesRequest) (*statusrpc.ListStatusesResponse, error) {
+	if len(request.GetStatusIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "status ids are required")
+	}
+
+	list, err := s.statuses.ReorderStatuses(ctx, request.GetStatusIds())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &statusrpc.ListStatusesResponse{Statuses: mapper.ToStatusesResponse(list)}, nil
+}
+
+func (s *serverApi) DeleteStatus(ctx context.Context, request *statusrpc.DeleteStatusRequest) (*emptypb.Empty, error) {
+	if request.GetStatusId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "status id is required")
+	}
+
+	err := s.statuses.RemoveStatus(ctx, request.GetStatusId(), request.GetFallbackStatusId())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, statuses.ErrStatusNotFound):
+		return status.Error(codes.NotFound, "status not found")
+	case errors.Is(err, statuses.ErrStatusExists):
+		return status.Error(codes.AlreadyExists, "status already exists")
+	case errors.Is(err, statuses.ErrStatusAccessDenied):
+		return status.Error(codes.PermissionDenied, "status is not owned by user")
+	case errors.Is(err, statuses.ErrInvalidFallback):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
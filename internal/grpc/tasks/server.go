@@ -2,13 +2,24 @@ package tasks
 
 import (
 	"context"
+	"errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"server/internal/domain/model"
 	"server/internal/lib/mapper"
+	"server/internal/services/tasks"
 	taskrpc "server/pkg/task"
+	"strings"
+	"time"
+)
+
+const (
+	maxSyncChanges     = 500
+	maxBatchOperations = 500
 )
 
 type serverApi struct {
@@ -21,23 +32,43 @@ func Register(gRPC *grpc.Server, tasks Tasks) {
 }
 
 type Tasks interface {
-	CreateTask(ctx context.Context, title string, body string) (int64, error)
-	RemoveTask(ctx context.Context, taskID int64) error
+	CreateTask(ctx context.Context, task model.RequestTask) (int64, error)
+	RemoveTask(ctx context.Context, taskID int64, cascade bool) error
 	FetchTask(ctx context.Context, taskID int64) (model.Task, error)
-	FetchTasks(ctx context.Context) ([]model.Task, error)
+	FetchTasks(ctx context.Context, filter model.TaskFilter, pageToken string) ([]model.Task, string, error)
+	UpdateTask(ctx context.Context, taskID int64, task model.UpdateTask) (model.Task, error)
+	ChangeTaskStatus(ctx context.Context, taskID int64, statusID int64) (model.Task, error)
+	FetchStatuses(ctx context.Context) ([]model.Status, error)
+	SearchTasks(ctx context.Context, query string, limit int) ([]model.TaskSearchResult, error)
+	FetchOverdueTasks(ctx context.Context, limit int) ([]model.Task, error)
+	MoveTask(ctx context.Context, taskID int64, projectID int64) (model.Task, error)
+	AssignTask(ctx context.Context, taskID int64, assigneeID int64) (model.Task, error)
+	UnassignTask(ctx context.Context, taskID int64, assigneeID int64) (model.Task, error)
+	AddChecklistItem(ctx context.Context, taskID int64, text string) (model.ChecklistItem, error)
+	ToggleChecklistItem(ctx context.Context, taskID int64, itemID int64, done bool) (model.ChecklistItem, error)
+	ReorderChecklistItems(ctx context.Context, taskID int64, itemIDs []int64) ([]model.ChecklistItem, error)
+	FetchTrash(ctx context.Context, limit int) ([]model.Task, error)
+	RestoreTask(ctx context.Context, taskID int64) (model.Task, error)
+	PurgeTask(ctx context.Context, taskID int64) error
+	FetchTaskHistory(ctx context.Context, taskID int64, pageSize int, pageToken string) ([]model.TaskEvent, string, error)
+	WatchTasks(ctx context.Context, afterSequence int64, send func(change model.TaskChange) error) error
+	SyncTasks(ctx context.Context, syncToken string, changes []model.TaskSyncChange, limit int) ([]model.TaskSyncResult, model.TaskDelta, error)
+	BatchTasks(ctx context.Context, operations []model.TaskBatchOperation, atomic bool) ([]model.TaskBatchResult, error)
+	ExportTasks(ctx context.Context, filter model.TaskFilter, format model.TaskFileFormat, w io.Writer) error
+	ImportTasks(ctx context.Context, options model.TaskImportOptions, r io.Reader) (model.TaskImportReport, error)
 }
 
 func (s *serverApi) CreateTask(ctx context.Context, request *taskrpc.CreateTaskRequest) (*taskrpc.CreateTaskResponse, error) {
-	err := validateCreateTaskRequest(request)
+	task, err := validateCreateTaskRequest(request)
 
 	if err != nil {
 		return nil, err
 	}
 
-	id, err := s.tasks.CreateTask(ctx, request.GetTitle(), request.GetBody())
+	id, err := s.tasks.CreateTask(ctx, task)
 
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, taskError(err)
 	}
 
 	return &taskrpc.CreateTaskResponse{
@@ -49,7 +80,7 @@ func (s *serverApi) GetTask(ctx context.Context, request *taskrpc.GetTaskRequest
 	task, err := s.tasks.FetchTask(ctx, request.GetTaskId())
 
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, taskError(err)
 	}
 
 	return mapper.ToTaskResponse(task), nil
@@ -62,39 +93,749 @@ func (s *serverApi) DeleteTask(ctx context.Context, request *taskrpc.DeleteTaskR
 		return nil, err
 	}
 
-	err = s.tasks.RemoveTask(ctx, request.GetTaskId())
+	err = s.tasks.RemoveTask(ctx, request.GetTaskId(), request.GetCascade())
 
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, taskError(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (s *serverApi) GetTasks(ctx context.Context, _ *emptypb.Empty) (*taskrpc.GetTasksResponse, error) {
-	tasks, err := s.tasks.FetchTasks(ctx)
+func (s *serverApi) GetTasks(ctx context.Context, request *taskrpc.ListTasksRequest) (*taskrpc.GetTasksResponse, error) {
+	filter, err := validateListTasksRequest(request)
 
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+
+	tasks, nextPageToken, err := s.tasks.FetchTasks(ctx, filter, request.GetPageToken())
+
+	if err != nil {
+		return nil, taskError(err)
 	}
 
 	response := mapper.ToTasksResponse(tasks)
 
-	return &taskrpc.GetTasksResponse{Tasks: response}, nil
+	return &taskrpc.GetTasksResponse{Tasks: response, NextPageToken: nextPageToken}, nil
 }
 
-func validateCreateTaskRequest(request *taskrpc.CreateTaskRequest) error {
+func (s *serverApi) UpdateTask(ctx context.Context, request *taskrpc.UpdateTaskRequest) (*taskrpc.GetTaskResponse, error) {
+	update, err := validateUpdateTaskRequest(request)
 
-	if request.GetTitle() == "" {
-		return status.Error(codes.InvalidArgument, "title is required")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.tasks.UpdateTask(ctx, request.GetTaskId(), update)
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToTaskResponse(task), nil
+}
+
+func (s *serverApi) ChangeTaskStatus(ctx context.Context, request *taskrpc.ChangeTaskStatusRequest) (*taskrpc.GetTaskResponse, error) {
+	err := validateChangeTaskStatusRequest(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.tasks.ChangeTaskStatus(ctx, request.GetTaskId(), request.GetStatusId())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToTaskResponse(task), nil
+}
+
+func (s *serverApi) GetStatuses(ctx context.Context, _ *emptypb.Empty) (*taskrpc.GetStatusesResponse, error) {
+	statuses, err := s.tasks.FetchStatuses(ctx)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &taskrpc.GetStatusesResponse{Statuses: mapper.ToStatusesResponse(statuses)}, nil
+}
+
+func (s *serverApi) SearchTasks(ctx context.Context, request *taskrpc.SearchTasksRequest) (*taskrpc.SearchTasksResponse, error) {
+	err := validateSearchTasksRequest(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.tasks.SearchTasks(ctx, request.GetQuery(), int(request.GetLimit()))
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &taskrpc.SearchTasksResponse{Results: mapper.ToSearchTasksResponse(results)}, nil
+}
+
+func (s *serverApi) ListOverdueTasks(ctx context.Context, request *taskrpc.ListOverdueTasksRequest) (*taskrpc.GetTasksResponse, error) {
+	if request.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	tasks, err := s.tasks.FetchOverdueTasks(ctx, int(request.GetLimit()))
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &taskrpc.GetTasksResponse{Tasks: mapper.ToTasksResponse(tasks)}, nil
+}
+
+func (s *serverApi) MoveTask(ctx context.Context, request *taskrpc.MoveTaskRequest) (*taskrpc.GetTaskResponse, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	task, err := s.tasks.MoveTask(ctx, request.GetTaskId(), request.GetProjectId())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToTaskResponse(task), nil
+}
+
+func (s *serverApi) AssignTask(ctx context.Context, request *taskrpc.AssignTaskRequest) (*taskrpc.GetTaskResponse, error) {
+	if err := validateAssignTaskRequest(request); err != nil {
+		return nil, err
+	}
+
+	task, err := s.tasks.AssignTask(ctx, request.GetTaskId(), request.GetUserId())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToTaskResponse(task), nil
+}
+
+func (s *serverApi) UnassignTask(ctx context.Context, request *taskrpc.AssignTaskRequest) (*taskrpc.GetTaskResponse, error) {
+	if err := validateAssignTaskRequest(request); err != nil {
+		return nil, err
+	}
+
+	task, err := s.tasks.UnassignTask(ctx, request.GetTaskId(), request.GetUserId())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToTaskResponse(task), nil
+}
+
+func (s *serverApi) AddChecklistItem(ctx context.Context, request *taskrpc.AddChecklistItemRequest) (*taskrpc.ChecklistItemData, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if strings.TrimSpace(request.GetText()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+
+	item, err := s.tasks.AddChecklistItem(ctx, request.GetTaskId(), request.GetText())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToChecklistItemResponse(item), nil
+}
+
+func (s *serverApi) ToggleChecklistItem(ctx context.Context, request *taskrpc.ToggleChecklistItemRequest) (*taskrpc.ChecklistItemData, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if request.GetItemId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+
+	item, err := s.tasks.ToggleChecklistItem(ctx, request.GetTaskId(), request.GetItemId(), request.GetDone())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToChecklistItemResponse(item), nil
+}
+
+func (s *serverApi) ReorderChecklistItems(ctx context.Context, request *taskrpc.ReorderChecklistItemsRequest) (*taskrpc.ChecklistResponse, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if len(request.GetItemIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "item ids are required")
+	}
+
+	items, err := s.tasks.ReorderChecklistItems(ctx, request.GetTaskId(), request.GetItemIds())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &taskrpc.ChecklistResponse{Items: mapper.ToChecklistResponse(items)}, nil
+}
+
+func (s *serverApi) ListTrash(ctx context.Context, request *taskrpc.ListTrashRequest) (*taskrpc.GetTasksResponse, error) {
+	if request.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	tasks, err := s.tasks.FetchTrash(ctx, int(request.GetLimit()))
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &taskrpc.GetTasksResponse{Tasks: mapper.ToTasksResponse(tasks)}, nil
+}
+
+func (s *serverApi) RestoreTask(ctx context.Context, request *taskrpc.RestoreTaskRequest) (*taskrpc.GetTaskResponse, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	task, err := s.tasks.RestoreTask(ctx, request.GetTaskId())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToTaskResponse(task), nil
+}
+
+func (s *serverApi) PurgeTask(ctx context.Context, request *taskrpc.PurgeTaskRequest) (*emptypb.Empty, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if err := s.tasks.PurgeTask(ctx, request.GetTaskId()); err != nil {
+		return nil, taskError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) GetTaskHistory(ctx context.Context, request *taskrpc.GetTaskHistoryRequest) (*taskrpc.GetTaskHistoryResponse, error) {
+	if request.GetTaskId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if request.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	events, nextPageToken, err := s.tasks.FetchTaskHistory(ctx, request.GetTaskId(), int(request.GetPageSize()), request.GetPageToken())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return &taskrpc.GetTaskHistoryResponse{Events: mapper.ToTaskEventsResponse(events), NextPageToken: nextPageToken}, nil
+}
+
+func (s *serverApi) WatchTasks(request *taskrpc.WatchTasksRequest, stream taskrpc.Task_WatchTasksServer) error {
+	if request.GetAfterSequence() < 0 {
+		return status.Error(codes.InvalidArgument, "after sequence must not be negative")
+	}
+
+	err := s.tasks.WatchTasks(stream.Context(), request.GetAfterSequence(), func(change model.TaskChange) error {
+		return stream.Send(mapper.ToTaskChangeResponse(change))
+	})
+
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return taskError(err)
 	}
 
 	return nil
 }
 
+func (s *serverApi) SyncTasks(ctx context.Context, request *taskrpc.SyncTasksRequest) (*taskrpc.SyncTasksResponse, error) {
+	changes, err := validateSyncTasksRequest(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results, delta, err := s.tasks.SyncTasks(ctx, request.GetSyncToken(), changes, int(request.GetLimit()))
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	return mapper.ToSyncTasksResponse(results, delta), nil
+}
+
+func (s *serverApi) BatchTasks(ctx context.Context, request *taskrpc.BatchTasksRequest) (*taskrpc.BatchTasksResponse, error) {
+	operations, err := validateBatchTasksRequest(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.tasks.BatchTasks(ctx, operations, request.GetAtomic())
+
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	response := &taskrpc.BatchTasksResponse{}
+
+	for _, result := range results {
+		r := mapper.ToTaskBatchResultResponse(result)
+
+		if result.Err != nil {
+			st := status.Convert(taskError(result.Err))
+			r.ErrorCode = int32(st.Code())
+			r.Error = st.Message()
+		}
+
+		response.Results = append(response.Results, r)
+	}
+
+	return response, nil
+}
+
+func taskError(err error) error {
+	switch {
+	case errors.Is(err, tasks.ErrTaskNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	case errors.Is(err, tasks.ErrTaskAccessDenied):
+		return status.Error(codes.PermissionDenied, "access to the task is denied")
+	case errors.Is(err, tasks.ErrTaskNotInTrash):
+		return status.Error(codes.FailedPrecondition, "task is not in the trash")
+	case errors.Is(err, tasks.ErrStatusNotFound):
+		return status.Error(codes.InvalidArgument, "status not found")
+	case errors.Is(err, tasks.ErrParentNotFound):
+		return status.Error(codes.InvalidArgument, "parent task not found")
+	case errors.Is(err, tasks.ErrUnknownBatchAction):
+		return status.Error(codes.InvalidArgument, "unknown batch action")
+	case errors.Is(err, tasks.ErrTransitionNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, tasks.ErrInvalidRecurrence):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, tasks.ErrRecurrenceNeedsDueDate):
+		return status.Error(codes.InvalidArgument, "recurring task needs a due date")
+	case errors.Is(err, tasks.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, "invalid page token")
+	case errors.Is(err, tasks.ErrInvalidSyncToken):
+		return status.Error(codes.InvalidArgument, "invalid sync token")
+	case errors.Is(err, tasks.ErrChecklistItemNotFound):
+		return status.Error(codes.NotFound, "checklist item not found")
+	case errors.Is(err, tasks.ErrProjectNotFound):
+		return status.Error(codes.NotFound, "project not found")
+	case errors.Is(err, tasks.ErrProjectAccessDenied):
+		return status.Error(codes.PermissionDenied, "project belongs to another user")
+	case errors.Is(err, tasks.ErrStatusNotInProject):
+		return status.Error(codes.FailedPrecondition, "status is not available in the project")
+	case errors.Is(err, tasks.ErrWorkspaceNotFound):
+		return status.Error(codes.NotFound, "workspace not found")
+	case errors.Is(err, tasks.ErrWorkspaceAccessDenied):
+		return status.Error(codes.PermissionDenied, "workspace role does not allow this operation")
+	case errors.Is(err, tasks.ErrAssigneeAccessDenied):
+		return status.Error(codes.FailedPrecondition, "assignee has no access to the task")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func validateCreateTaskRequest(request *taskrpc.CreateTaskRequest) (model.RequestTask, error) {
+	var task model.RequestTask
+
+	if request.GetTitle() == "" {
+		return task, status.Error(codes.InvalidArgument, "title is required")
+	}
+
+	task.Title = request.GetTitle()
+
+	task.Body = request.GetBody()
+
+	dueAt, err := optionalTime(request.GetDueAt(), "due_at")
+
+	if err != nil {
+		return task, err
+	}
+
+	remindAt, err := optionalTime(request.GetRemindAt(), "remind_at")
+
+	if err != nil {
+		return task, err
+	}
+
+	task.DueAt = dueAt
+
+	task.RemindAt = remindAt
+
+	priority, err := toPriority(request.GetPriority())
+
+	if err != nil {
+		return task, err
+	}
+
+	task.Priority = priority
+
+	task.ParentID = request.GetParentId()
+
+	task.ProjectID = request.GetProjectId()
+
+	task.WorkspaceID = request.GetWorkspaceId()
+
+	task.Recurrence = model.Recurrence{Rule: request.GetRecurrence(), Timezone: request.GetRecurrenceTimezone()}
+
+	return task, nil
+}
+
+// toPriority checks that the enum value is known; the proto and model values match.
+func toPriority(priority taskrpc.TaskPriority) (model.Priority, error) {
+	if _, ok := taskrpc.TaskPriority_name[int32(priority)]; !ok {
+		return 0, status.Error(codes.InvalidArgument, "unknown priority")
+	}
+
+	return model.Priority(priority), nil
+}
+
+// optionalTime converts an optional timestamp to UTC; a missing one yields nil.
+func optionalTime(ts *timestamppb.Timestamp, field string) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+
+	if err := ts.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is invalid", field)
+	}
+
+	at := ts.AsTime().UTC()
+
+	return &at, nil
+}
+
 func validateDeleteTaskRequest(request *taskrpc.DeleteTaskRequest) error {
 	if request.GetTaskId() == 0 {
 		return status.Error(codes.InvalidArgument, "task id is required")
 	}
 	return nil
 }
+
+func validateUpdateTaskRequest(request *taskrpc.UpdateTaskRequest) (model.UpdateTask, error) {
+	var update model.UpdateTask
+
+	if request.GetTaskId() == 0 {
+		return update, status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	paths := request.GetUpdateMask().GetPaths()
+
+	if len(paths) == 0 {
+		return update, status.Error(codes.InvalidArgument, "update mask is required")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "title":
+			if request.GetTitle() == "" {
+				return update, status.Error(codes.InvalidArgument, "title is required")
+			}
+			title := request.GetTitle()
+			update.Title = &title
+		case "body":
+			body := request.GetBody()
+			update.Body = &body
+		case "status_id":
+			if request.GetStatusId() == 0 {
+				return update, status.Error(codes.InvalidArgument, "status id is required")
+			}
+			statusID := request.GetStatusId()
+			update.StatusID = &statusID
+		case "due_at":
+			dueAt, err := optionalTime(request.GetDueAt(), "due_at")
+			if err != nil {
+				return update, err
+			}
+			if dueAt == nil {
+				dueAt = &time.Time{}
+			}
+			update.DueAt = dueAt
+		case "remind_at":
+			remindAt, err := optionalTime(request.GetRemindAt(), "remind_at")
+			if err != nil {
+				return update, err
+			}
+			if remindAt == nil {
+				remindAt = &time.Time{}
+			}
+			update.RemindAt = remindAt
+		case "priority":
+			priority, err := toPriority(request.GetPriority())
+			if err != nil {
+				return update, err
+			}
+			update.Priority = &priority
+		case "recurrence":
+			update.Recurrence = &model.Recurrence{Rule: request.GetRecurrence(), Timezone: request.GetRecurrenceTimezone()}
+		default:
+			return update, status.Errorf(codes.InvalidArgument, "unknown update mask path %q", path)
+		}
+	}
+
+	return update, nil
+}
+
+func validateBatchTasksRequest(request *taskrpc.BatchTasksRequest) ([]model.TaskBatchOperation, error) {
+	if len(request.GetOperations()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "operations are required")
+	}
+
+	if len(request.GetOperations()) > maxBatchOperations {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d operations per batch", maxBatchOperations)
+	}
+
+	operations := make([]model.TaskBatchOperation, 0, len(request.GetOperations()))
+
+	for i, o := range request.GetOperations() {
+		var operation model.TaskBatchOperation
+		var err error
+
+		switch o := o.GetOperation().(type) {
+		case *taskrpc.TaskBatchOperation_Create:
+			operation.Action = model.TaskBatchCreate
+			operation.Task, err = validateCreateTaskRequest(o.Create)
+		case *taskrpc.TaskBatchOperation_Update:
+			operation.Action = model.TaskBatchUpdate
+			operation.TaskID = o.Update.GetTaskId()
+			operation.Update, err = validateUpdateTaskRequest(o.Update)
+		case *taskrpc.TaskBatchOperation_ChangeStatus:
+			operation.Action = model.TaskBatchChangeStatus
+			operation.TaskID = o.ChangeStatus.GetTaskId()
+			operation.StatusID = o.ChangeStatus.GetStatusId()
+			err = validateChangeTaskStatusRequest(o.ChangeStatus)
+		case *taskrpc.TaskBatchOperation_Delete:
+			operation.Action = model.TaskBatchDelete
+			operation.TaskID = o.Delete.GetTaskId()
+			operation.Cascade = o.Delete.GetCascade()
+			err = validateDeleteTaskRequest(o.Delete)
+		default:
+			err = status.Error(codes.InvalidArgument, "operation is required")
+		}
+
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "operation %d: %s", i, status.Convert(err).Message())
+		}
+
+		operations = append(operations, operation)
+	}
+
+	return operations, nil
+}
+
+func validateSyncTasksRequest(request *taskrpc.SyncTasksRequest) ([]model.TaskSyncChange, error) {
+	if request.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	if len(request.GetChanges()) > maxSyncChanges {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d changes per sync", maxSyncChanges)
+	}
+
+	changes := make([]model.TaskSyncChange, 0, len(request.GetChanges()))
+
+	for _, c := range request.GetChanges() {
+		change := model.TaskSyncChange{
+			ClientID:     c.GetClientId(),
+			TaskID:       c.GetTaskId(),
+			BaseRevision: c.GetBaseRevision(),
+			Deleted:      c.GetDeleted(),
+		}
+
+		if c.GetChangedAt() != nil {
+			change.ChangedAt = c.GetChangedAt().AsTime()
+		}
+
+		if change.Deleted && change.TaskID == 0 {
+			return nil, status.Error(codes.InvalidArgument, "task id is required to delete a task")
+		}
+
+		if !change.Deleted {
+			update, err := validateSyncChangeMask(c)
+
+			if err != nil {
+				return nil, err
+			}
+
+			change.Update = update
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func validateSyncChangeMask(change *taskrpc.TaskSyncChange) (model.UpdateTask, error) {
+	var update model.UpdateTask
+
+	paths := change.GetUpdateMask().GetPaths()
+
+	if len(paths) == 0 {
+		return update, status.Error(codes.InvalidArgument, "update mask is required")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "title":
+			if change.GetTitle() == "" {
+				return update, status.Error(codes.InvalidArgument, "title is required")
+			}
+			title := change.GetTitle()
+			update.Title = &title
+		case "body":
+			body := change.GetBody()
+			update.Body = &body
+		case "status_id":
+			if change.GetStatusId() == 0 {
+				return update, status.Error(codes.InvalidArgument, "status id is required")
+			}
+			statusID := change.GetStatusId()
+			update.StatusID = &statusID
+		case "due_at":
+			dueAt, err := optionalTime(change.GetDueAt(), "due_at")
+			if err != nil {
+				return update, err
+			}
+			if dueAt == nil {
+				dueAt = &time.Time{}
+			}
+			update.DueAt = dueAt
+		case "remind_at":
+			remindAt, err := optionalTime(change.GetRemindAt(), "remind_at")
+			if err != nil {
+				return update, err
+			}
+			if remindAt == nil {
+				remindAt = &time.Time{}
+			}
+			update.RemindAt = remindAt
+		case "priority":
+			priority, err := toPriority(change.GetPriority())
+			if err != nil {
+				return update, err
+			}
+			update.Priority = &priority
+		default:
+			return update, status.Errorf(codes.InvalidArgument, "unknown update mask path %q", path)
+		}
+	}
+
+	if change.GetTaskId() == 0 && update.Title == nil {
+		return update, status.Error(codes.InvalidArgument, "title is required to create a task")
+	}
+
+	return update, nil
+}
+
+func validateAssignTaskRequest(request *taskrpc.AssignTaskRequest) error {
+	if request.GetTaskId() == 0 {
+		return status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if request.GetUserId() == 0 {
+		return status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	return nil
+}
+
+func validateChangeTaskStatusRequest(request *taskrpc.ChangeTaskStatusRequest) error {
+	if request.GetTaskId() == 0 {
+		return status.Error(codes.InvalidArgument, "task id is required")
+	}
+
+	if request.GetStatusId() == 0 {
+		return status.Error(codes.InvalidArgument, "status id is required")
+	}
+
+	return nil
+}
+
+func validateListTasksRequest(request *taskrpc.ListTasksRequest) (model.TaskFilter, error) {
+	var filter model.TaskFilter
+
+	if request.GetPageSize() < 0 {
+		return filter, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	filter.Limit = int(request.GetPageSize())
+
+	filter.StatusIDs = request.GetStatusIds()
+
+	filter.ProjectID = request.GetProjectId()
+
+	filter.WorkspaceID = request.GetWorkspaceId()
+
+	filter.AssignedToMe = request.GetAssignedToMe()
+
+	filter.LabelIDs = request.GetLabelIds()
+
+	for _, p := range request.GetPriorities() {
+		priority, err := toPriority(p)
+
+		if err != nil {
+			return filter, err
+		}
+
+		filter.Priorities = append(filter.Priorities, priority)
+	}
+
+	if request.GetCreatedAfter() != nil {
+		filter.CreatedAfter = request.GetCreatedAfter().AsTime()
+	}
+
+	if request.GetCreatedBefore() != nil {
+		filter.CreatedBefore = request.GetCreatedBefore().AsTime()
+	}
+
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return filter, status.Error(codes.InvalidArgument, "created_after must be before created_before")
+	}
+
+	switch request.GetSortOrder() {
+	case taskrpc.TaskSortOrder_TASK_SORT_ORDER_UNSPECIFIED, taskrpc.TaskSortOrder_TASK_SORT_ORDER_CREATED_AT_DESC:
+		filter.Sort = model.TaskSortCreatedAtDesc
+	case taskrpc.TaskSortOrder_TASK_SORT_ORDER_CREATED_AT_ASC:
+		filter.Sort = model.TaskSortCreatedAtAsc
+	case taskrpc.TaskSortOrder_TASK_SORT_ORDER_TITLE_ASC:
+		filter.Sort = model.TaskSortTitleAsc
+	case taskrpc.TaskSortOrder_TASK_SORT_ORDER_TITLE_DESC:
+		filter.Sort = model.TaskSortTitleDesc
+	default:
+		return filter, status.Error(codes.InvalidArgument, "unknown sort order")
+	}
+
+	return filter, nil
+}
+
+func validateSearchTasksRequest(request *taskrpc.SearchTasksRequest) error {
+	if strings.TrimSpace(request.GetQuery()) == "" {
+		return status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	if request.GetLimit() < 0 {
+		return status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	return nil
+}
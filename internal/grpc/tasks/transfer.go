@@ -0,0 +1,168 @@
+package tasks
+
+import (
+	"bufio"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"server/internal/domain/model"
+	"server/internal/lib/taskfile"
+	"server/internal/services/tasks"
+	taskrpc "server/pkg/task"
+)
+
+// chunkSize is the size of the chunks an export is sent in.
+const chunkSize = 64 * 1024
+
+func (s *serverApi) ExportTasks(request *taskrpc.ExportTasksRequest, stream taskrpc.Task_ExportTasksServer) error {
+	format, err := toFileFormat(request.GetFormat())
+
+	if err != nil {
+		return err
+	}
+
+	filter := model.TaskFilter{ProjectID: request.GetProjectId(), WorkspaceID: request.GetWorkspaceId()}
+
+	w := bufio.NewWriterSize(&chunkWriter{stream: stream}, chunkSize)
+
+	if err := s.tasks.ExportTasks(stream.Context(), filter, format, w); err != nil {
+		return transferError(err)
+	}
+
+	if err := w.Flush(); err != nil {
+		return transferError(err)
+	}
+
+	return nil
+}
+
+func (s *serverApi) ImportTasks(stream taskrpc.Task_ImportTasksServer) error {
+	first, err := stream.Recv()
+
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "import info is required")
+		}
+		return err
+	}
+
+	info := first.GetInfo()
+
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry the import info")
+	}
+
+	format, err := toFileFormat(info.GetFormat())
+
+	if err != nil {
+		return err
+	}
+
+	report, err := s.tasks.ImportTasks(stream.Context(), model.TaskImportOptions{
+		Format:      format,
+		ProjectID:   info.GetProjectId(),
+		WorkspaceID: info.GetWorkspaceId(),
+		DryRun:      info.GetDryRun(),
+	}, &chunkReader{stream: stream})
+
+	if err != nil {
+		return transferError(err)
+	}
+
+	response := &taskrpc.ImportTasksResponse{
+		Rows:     int32(report.Rows),
+		Imported: int32(report.Imported),
+		Failed:   int32(report.Failed),
+		DryRun:   report.DryRun,
+	}
+
+	for _, rowErr := range report.Errors {
+		response.Errors = append(response.Errors, &taskrpc.ImportRowError{Row: int32(rowErr.Row), Error: importRowError(rowErr.Err)})
+	}
+
+	return stream.SendAndClose(response)
+}
+
+func toFileFormat(format taskrpc.TaskFileFormat) (model.TaskFileFormat, error) {
+	switch format {
+	case taskrpc.TaskFileFormat_TASK_FILE_FORMAT_JSON_LINES:
+		return model.TaskFileJSONLines, nil
+	case taskrpc.TaskFileFormat_TASK_FILE_FORMAT_CSV:
+		return model.TaskFileCSV, nil
+	case taskrpc.TaskFileFormat_TASK_FILE_FORMAT_TODOIST_CSV:
+		return model.TaskFileTodoistCSV, nil
+	}
+
+	return model.TaskFileUnspecified, status.Error(codes.InvalidArgument, "file format is required")
+}
+
+// importRowError tells what is wrong with a row without giving away internal
+// errors.
+func importRowError(err error) string {
+	var rowErr *taskfile.RowError
+
+	if errors.As(err, &rowErr) {
+		return rowErr.Err.Error()
+	}
+
+	return status.Convert(taskError(err)).Message()
+}
+
+func transferError(err error) error {
+	// Failures of the stream itself already carry a status.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, tasks.ErrUnsupportedFormat):
+		return status.Error(codes.InvalidArgument, "the file format is not supported here")
+	case errors.Is(err, tasks.ErrImportTooLarge):
+		return status.Error(codes.InvalidArgument, "import has too many rows")
+	case errors.Is(err, tasks.ErrInvalidImportFile):
+		return status.Error(codes.InvalidArgument, "the header lacks a required column: title, or TYPE and CONTENT for Todoist")
+	}
+
+	return taskError(err)
+}
+
+// chunkWriter sends what is written to it as export chunks.
+type chunkWriter struct {
+	stream taskrpc.Task_ExportTasksServer
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	if err := c.stream.Send(&taskrpc.ExportTasksResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// chunkReader turns the chunks of an import stream into an io.Reader.
+type chunkReader struct {
+	stream taskrpc.Task_ImportTasksServer
+	buf    []byte
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		request, err := c.stream.Recv()
+
+		if err != nil {
+			return 0, err
+		}
+
+		if request.GetInfo() != nil {
+			return 0, status.Error(codes.InvalidArgument, "import info must be sent only once")
+		}
+
+		c.buf = request.GetChunk()
+	}
+
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+
+	return n, nil
+}
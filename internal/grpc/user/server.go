@@ -23,8 +23,16 @@ type User interface {
 	FetchUser(ctx context.Context, ID int64) (model.User, error)
 	RefreshToken(ctx context.Context, refreshToken string) (model.Tokens, error)
 	LogOut(ctx context.Context, userID int64, deviceID string, sessionID string) error
+	ChangePassword(ctx context.Context, currentPassword string, newPassword string) error
+	RequestPasswordReset(ctx context.Context, login string) error
+	ResetPassword(ctx context.Context, token string, newPassword string) error
+	UpdateUser(ctx context.Context, update model.UpdateUser) (model.User, error)
+	DeleteAccount(ctx context.Context, password string) error
 }
 
+// maxPasswordBytes is the longest password bcrypt can hash.
+const maxPasswordBytes = 72
+
 // Хэндлеры
 type serverApi struct {
 	userRpc.UnimplementedUserServer
@@ -135,6 +143,141 @@ func (s *serverApi) LogOut(ctx context.Context, request *emptypb.Empty) (*emptyp
 	return nil, nil
 }
 
+func (s *serverApi) ChangePassword(ctx context.Context, request *userRpc.ChangePasswordRequest) (*emptypb.Empty, error) {
+	if request.GetCurrentPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "CurrentPassword is required")
+	}
+
+	if err := validateNewPassword(request.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	if err := s.user.ChangePassword(ctx, request.GetCurrentPassword(), request.GetNewPassword()); err != nil {
+		return nil, passwordError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) RequestPasswordReset(ctx context.Context, request *userRpc.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if request.GetLogin() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Login is required")
+	}
+
+	if err := s.user.RequestPasswordReset(ctx, request.GetLogin()); err != nil {
+		return nil, passwordError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) ResetPassword(ctx context.Context, request *userRpc.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if request.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Token is required")
+	}
+
+	if err := validateNewPassword(request.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	if err := s.user.ResetPassword(ctx, request.GetToken(), request.GetNewPassword()); err != nil {
+		return nil, passwordError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) UpdateUser(ctx context.Context, request *userRpc.UpdateUserRequest) (*userRpc.UserData, error) {
+	update, err := validateUpdateUser(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.user.UpdateUser(ctx, update)
+
+	if err != nil {
+		if errors.Is(err, user.ErrUserExists) {
+			return nil, status.Error(codes.AlreadyExists, "login is already taken")
+		}
+		return nil, passwordError(err)
+	}
+
+	return &userRpc.UserData{
+		UserId:   u.ID,
+		Username: u.Name,
+		Login:    u.Login,
+	}, nil
+}
+
+func (s *serverApi) DeleteAccount(ctx context.Context, request *userRpc.DeleteAccountRequest) (*emptypb.Empty, error) {
+	if request.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Password is required")
+	}
+
+	if err := s.user.DeleteAccount(ctx, request.GetPassword()); err != nil {
+		return nil, passwordError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func passwordError(err error) error {
+	switch {
+	case errors.Is(err, user.ErrInvalidCredentials):
+		return status.Error(codes.PermissionDenied, "invalid credentials")
+	case errors.Is(err, user.ErrInvalidResetToken):
+		return status.Error(codes.InvalidArgument, "invalid or expired password reset token")
+	case errors.Is(err, user.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func validateNewPassword(password string) error {
+	if password == "" {
+		return status.Error(codes.InvalidArgument, "NewPassword is required")
+	}
+
+	if len(password) > maxPasswordBytes {
+		return status.Error(codes.InvalidArgument, "NewPassword is too long")
+	}
+
+	return nil
+}
+
+func validateUpdateUser(req *userRpc.UpdateUserRequest) (model.UpdateUser, error) {
+	var update model.UpdateUser
+
+	paths := req.GetUpdateMask().GetPaths()
+
+	if len(paths) == 0 {
+		return update, status.Error(codes.InvalidArgument, "update mask is required")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "username":
+			if req.GetUsername() == "" {
+				return update, status.Error(codes.InvalidArgument, "Username is required")
+			}
+			name := req.GetUsername()
+			update.Name = &name
+		case "login":
+			if req.GetLogin() == "" {
+				return update, status.Error(codes.InvalidArgument, "Login is required")
+			}
+			login := req.GetLogin()
+			update.Login = &login
+		default:
+			return update, status.Errorf(codes.InvalidArgument, "unknown update mask path %q", path)
+		}
+	}
+
+	return update, nil
+}
+
 func validateLogin(req *userRpc.LoginUserRequest) error {
 	if req.GetLogin() == "" {
 		return status.Error(codes.InvalidArgument, "Login is required")
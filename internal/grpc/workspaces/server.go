@@ -0,0 +1,155 @@
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"server/internal/domain/model"
+	"server/internal/lib/mapper"
+	"server/internal/services/workspaces"
+	workspacerpc "server/pkg/workspace"
+)
+
+type serverApi struct {
+	workspacerpc.UnimplementedWorkspaceServer
+	workspaces Workspaces
+}
+
+func Register(gRPC *grpc.Server, workspaces Workspaces) {
+	workspacerpc.RegisterWorkspaceServer(gRPC, &serverApi{workspaces: workspaces})
+}
+
+type Workspaces interface {
+	CreateWorkspace(ctx context.Context, name string) (int64, error)
+	FetchWorkspace(ctx context.Context, workspaceID int64) (model.Workspace, error)
+	FetchWorkspaces(ctx context.Context) ([]model.Workspace, error)
+	InviteMember(ctx context.Context, workspaceID int64, login string, role model.Role) (model.WorkspaceMember, error)
+	RemoveMember(ctx context.Context, workspaceID int64, memberID int64) error
+	ChangeMemberRole(ctx context.Context, workspaceID int64, memberID int64, role model.Role) (model.WorkspaceMember, error)
+}
+
+func (s *serverApi) CreateWorkspace(ctx context.Context, request *workspacerpc.CreateWorkspaceRequest) (*workspacerpc.CreateWorkspaceResponse, error) {
+	if request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	id, err := s.workspaces.CreateWorkspace(ctx, request.GetName())
+
+	if err != nil {
+		return nil, workspaceError(err)
+	}
+
+	return &workspacerpc.CreateWorkspaceResponse{WorkspaceId: id}, nil
+}
+
+func (s *serverApi) GetWorkspace(ctx context.Context, request *workspacerpc.GetWorkspaceRequest) (*workspacerpc.WorkspaceData, error) {
+	if request.GetWorkspaceId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace id is required")
+	}
+
+	workspace, err := s.workspaces.FetchWorkspace(ctx, request.GetWorkspaceId())
+
+	if err != nil {
+		return nil, workspaceError(err)
+	}
+
+	return mapper.ToWorkspaceResponse(workspace), nil
+}
+
+func (s *serverApi) ListWorkspaces(ctx context.Context, _ *emptypb.Empty) (*workspacerpc.ListWorkspacesResponse, error) {
+	list, err := s.workspaces.FetchWorkspaces(ctx)
+
+	if err != nil {
+		return nil, workspaceError(err)
+	}
+
+	return &workspacerpc.ListWorkspacesResponse{Workspaces: mapper.ToWorkspacesResponse(list)}, nil
+}
+
+func (s *serverApi) InviteMember(ctx context.Context, request *workspacerpc.InviteMemberRequest) (*workspacerpc.MemberData, error) {
+	if request.GetWorkspaceId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace id is required")
+	}
+
+	if request.GetLogin() == "" {
+		return nil, status.Error(codes.InvalidArgument, "login is required")
+	}
+
+	role := mapper.FromWorkspaceRole(request.GetRole())
+
+	if role == "" {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	member, err := s.workspaces.InviteMember(ctx, request.GetWorkspaceId(), request.GetLogin(), role)
+
+	if err != nil {
+		return nil, workspaceError(err)
+	}
+
+	return mapper.ToMemberResponse(member), nil
+}
+
+func (s *serverApi) RemoveMember(ctx context.Context, request *workspacerpc.RemoveMemberRequest) (*emptypb.Empty, error) {
+	if request.GetWorkspaceId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace id is required")
+	}
+
+	if request.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := s.workspaces.RemoveMember(ctx, request.GetWorkspaceId(), request.GetUserId()); err != nil {
+		return nil, workspaceError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *serverApi) ChangeMemberRole(ctx context.Context, request *workspacerpc.ChangeMemberRoleRequest) (*workspacerpc.MemberData, error) {
+	if request.GetWorkspaceId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace id is required")
+	}
+
+	if request.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	role := mapper.FromWorkspaceRole(request.GetRole())
+
+	if role == "" {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	member, err := s.workspaces.ChangeMemberRole(ctx, request.GetWorkspaceId(), request.GetUserId(), role)
+
+	if err != nil {
+		return nil, workspaceError(err)
+	}
+
+	return mapper.ToMemberResponse(member), nil
+}
+
+func workspaceError(err error) error {
+	switch {
+	case errors.Is(err, workspaces.ErrWorkspaceNotFound):
+		return status.Error(codes.NotFound, "workspace not found")
+	case errors.Is(err, workspaces.ErrWorkspaceAccessDenied):
+		return status.Error(codes.PermissionDenied, "user is not a member of the workspace")
+	case errors.Is(err, workspaces.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "workspace role does not allow this operation")
+	case errors.Is(err, workspaces.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, "invalid role")
+	case errors.Is(err, workspaces.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, workspaces.ErrMemberExists):
+		return status.Error(codes.AlreadyExists, "user is already a member")
+	case errors.Is(err, workspaces.ErrMemberNotFound):
+		return status.Error(codes.NotFound, "member not found")
+	}
+
+	return status.Error(codes.Internal, "internal server error")
+}
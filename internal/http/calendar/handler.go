@@ -0,0 +1,58 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"server/internal/services/calendar"
+	"strings"
+)
+
+const contentType = "text/calendar; charset=utf-8"
+
+type handler struct {
+	log      *slog.Logger
+	calendar Calendar
+}
+
+// Register serves the calendar feeds at /calendar/{token}.ics; the token in
+// the path is the only credential, so calendar apps can subscribe to it.
+func Register(mux *http.ServeMux, log *slog.Logger, calendar Calendar) {
+	mux.Handle("GET /calendar/{file}", &handler{log: log, calendar: calendar})
+}
+
+type Calendar interface {
+	FeedCalendar(ctx context.Context, token string) ([]byte, error)
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const op = "http.calendar.feed"
+
+	token, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	data, err := h.calendar.FeedCalendar(r.Context(), token)
+
+	if err != nil {
+		if errors.Is(err, calendar.ErrFeedNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+
+		h.log.With(slog.String("op", op)).Error("failed to render calendar feed", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	if _, err := w.Write(data); err != nil {
+		h.log.With(slog.String("op", op)).Warn("failed to write calendar feed", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,70 @@
+package hub
+
+import (
+	"server/internal/domain/model"
+	"slices"
+	"sync"
+)
+
+// bufferSize is how many events a subscriber may lag behind before it is
+// dropped; a dropped subscriber resumes from its last sequence number.
+const bufferSize = 256
+
+// Hub fans task events out to the subscribers in this process. Every event
+// comes with its audience, the users who may see the task, and reaches only
+// their subscriptions.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	userID int64
+	events chan model.TaskEvent
+}
+
+func New() *Hub {
+	return &Hub{subscribers: make(map[*subscriber]struct{})}
+}
+
+// Subscribe returns the events published for the user and a function that
+// ends the subscription. The channel is closed when the subscriber falls too
+// far behind.
+func (h *Hub) Subscribe(userID int64) (<-chan model.TaskEvent, func()) {
+	s := &subscriber{userID: userID, events: make(chan model.TaskEvent, bufferSize)}
+
+	h.mu.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mu.Unlock()
+
+	return s.events, func() { h.drop(s) }
+}
+
+// Publish never blocks: a subscriber whose buffer is full is dropped instead.
+func (h *Hub) Publish(event model.TaskEvent, audience []int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscribers {
+		if !slices.Contains(audience, s.userID) {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			delete(h.subscribers, s)
+			close(s.events)
+		}
+	}
+}
+
+func (h *Hub) drop(s *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[s]; ok {
+		delete(h.subscribers, s)
+		close(s.events)
+	}
+}
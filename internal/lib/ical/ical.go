@@ -0,0 +1,184 @@
+// Package ical writes iCalendar (RFC 5545) calendars of to-dos.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the longest content line allowed, line break excluded
+// (RFC 5545, section 3.1).
+const maxLineOctets = 75
+
+const dateTimeLayout = "20060102T150405Z"
+
+type Status string
+
+const (
+	StatusNeedsAction Status = "NEEDS-ACTION"
+	StatusInProcess   Status = "IN-PROCESS"
+	StatusCompleted   Status = "COMPLETED"
+	StatusCancelled   Status = "CANCELLED"
+)
+
+type Calendar struct {
+	ProdID string
+	// Name is shown by the calendar apps that support X-WR-CALNAME.
+	Name  string
+	Todos []Todo
+}
+
+// Todo is a VTODO component. Times are written in UTC; zero or nil ones are
+// left out.
+type Todo struct {
+	UID         string
+	Summary     string
+	Description string
+	Stamp       time.Time
+	Created     time.Time
+	// Start anchors the recurrence; it is required along with RRule.
+	Start  *time.Time
+	Due    *time.Time
+	Status Status
+	// Priority runs from 1 (highest) to 9 (lowest); 0 leaves it undefined.
+	Priority int
+	// RRule is the RRULE value, e.g. "FREQ=WEEKLY;BYDAY=MO".
+	RRule string
+}
+
+// Encode writes the calendar with CRLF line breaks, escaping text values and
+// folding lines longer than 75 octets.
+func Encode(w io.Writer, calendar Calendar) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", calendar.ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+
+	if calendar.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(calendar.Name))
+	}
+
+	for _, todo := range calendar.Todos {
+		e.todo(todo)
+	}
+
+	e.line("END", "VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+
+	return e.w.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) todo(todo Todo) {
+	e.line("BEGIN", "VTODO")
+	e.line("UID", todo.UID)
+	e.time("DTSTAMP", &todo.Stamp)
+	e.time("CREATED", &todo.Created)
+	e.line("SUMMARY", escapeText(todo.Summary))
+
+	if todo.Description != "" {
+		e.line("DESCRIPTION", escapeText(todo.Description))
+	}
+
+	e.time("DTSTART", todo.Start)
+	e.time("DUE", todo.Due)
+
+	if todo.Status != "" {
+		e.line("STATUS", string(todo.Status))
+	}
+
+	if todo.Priority > 0 {
+		e.line("PRIORITY", strconv.Itoa(todo.Priority))
+	}
+
+	if todo.RRule != "" {
+		e.line("RRULE", todo.RRule)
+	}
+
+	e.line("END", "VTODO")
+}
+
+func (e *encoder) time(name string, at *time.Time) {
+	if at == nil || at.IsZero() {
+		return
+	}
+
+	e.line(name, at.UTC().Format(dateTimeLayout))
+}
+
+// line writes one content line; value must be escaped already.
+func (e *encoder) line(name string, value string) {
+	if e.err != nil {
+		return
+	}
+
+	_, e.err = e.w.WriteString(fold(name + ":" + value))
+}
+
+// fold breaks a content line into lines of at most 75 octets, each
+// continuation starting with a space, and never inside a UTF-8 sequence
+// (RFC 5545, section 3.1). The result ends with CRLF.
+func fold(line string) string {
+	var b strings.Builder
+
+	limit := maxLineOctets
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+
+		// The leading space of a continuation counts towards its length.
+		limit = maxLineOctets - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+
+	return b.String()
+}
+
+// escapeText escapes a TEXT value (RFC 5545, section 3.3.11): backslashes,
+// semicolons, commas and line breaks are escaped, other control characters
+// are dropped.
+func escapeText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	var b strings.Builder
+
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == ';' || r == ',':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteRune(r)
+		case r < 0x20 || r == 0x7f:
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{"short", "SUMMARY:tea", "SUMMARY:tea\r\n"},
+		{"empty", "", "\r\n"},
+		{"exactly 75 octets", strings.Repeat("a", 75), strings.Repeat("a", 75) + "\r\n"},
+		{"76 octets", strings.Repeat("a", 76), strings.Repeat("a", 75) + "\r\n a\r\n"},
+		{"continuation of 74 octets", strings.Repeat("a", 149), strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n"},
+		{"second continuation", strings.Repeat("a", 150), strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n"},
+		{"two-octet rune across the fold", strings.Repeat("a", 74) + "é", strings.Repeat("a", 74) + "\r\n é\r\n"},
+		{"two-octet rune before the fold", strings.Repeat("a", 73) + "éb", strings.Repeat("a", 73) + "é\r\n b\r\n"},
+		{"three-octet rune across the fold", strings.Repeat("a", 73) + "€", strings.Repeat("a", 73) + "\r\n €\r\n"},
+		{"four-octet rune across the fold", strings.Repeat("a", 72) + "😀", strings.Repeat("a", 72) + "\r\n 😀\r\n"},
+		{"runes only", strings.Repeat("я", 40), strings.Repeat("я", 37) + "\r\n " + strings.Repeat("я", 3) + "\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fold(tt.line)
+
+			if got != tt.want {
+				t.Fatalf("fold(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+
+			if !strings.HasSuffix(got, "\r\n") {
+				t.Fatalf("%q does not end with CRLF", got)
+			}
+
+			lines := strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n")
+
+			for i, line := range lines {
+				if len(line) > maxLineOctets {
+					t.Fatalf("line %d is %d octets long", i, len(line))
+				}
+
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Fatalf("continuation line %d does not start with a space", i)
+				}
+			}
+
+			if unfolded := strings.ReplaceAll(strings.TrimSuffix(got, "\r\n"), "\r\n ", ""); unfolded != tt.line {
+				t.Fatalf("unfolded to %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"plain", "Buy tea", "Buy tea"},
+		{"backslash", `C:\tasks`, `C:\\tasks`},
+		{"semicolon", "tea; milk", `tea\; milk`},
+		{"comma", "tea, milk", `tea\, milk`},
+		{"line feed", "tea\nmilk", `tea\nmilk`},
+		{"CRLF", "tea\r\nmilk", `tea\nmilk`},
+		{"carriage return", "tea\rmilk", `tea\nmilk`},
+		{"escaped sequence stays literal", `\n`, `\\n`},
+		{"tab kept", "tea\tmilk", "tea\tmilk"},
+		{"control characters dropped", "t\x00e\x07a\x1b\x7f", "tea"},
+		{"non-ASCII kept", "чай, молоко", `чай\, молоко`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeText(tt.text); got != tt.want {
+				t.Fatalf("escapeText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
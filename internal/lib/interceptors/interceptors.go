@@ -2,9 +2,10 @@ package interceptors
 
 import (
 	"context"
-	"fmt"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"server/internal/lib/jwt"
 	"strings"
 )
@@ -12,41 +13,111 @@ import (
 var authFreeMethods = map[string]bool{
 	"/user.User/RegisterUser": true,
 	"/user.User/LoginUser":    true,
+	// The reset flow is for users who cannot sign in.
+	"/user.User/RequestPasswordReset": true,
+	"/user.User/ResetPassword":        true,
 }
 
-func IsAuth(
+// SessionChecker tells whether the session an access token was issued for is
+// still open and its account still exists.
+type SessionChecker interface {
+	SessionActive(ctx context.Context, userID int64, sessionID string) (bool, error)
+}
+
+// Auth authenticates the calls. An access token stays valid only as long as
+// its session and account: signing out, a password change or reset and
+// deleting the account reject the tokens issued before right away.
+type Auth struct {
+	sessions SessionChecker
+}
+
+func NewAuth(sessions SessionChecker) *Auth {
+	return &Auth{sessions: sessions}
+}
+
+func (a *Auth) IsAuth(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
 
-	if authFreeMethods[info.FullMethod] {
-		return handler(ctx, req)
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// IsAuthStream is the streaming counterpart of IsAuth: the handler gets a
+// stream whose context carries the claims.
+func (a *Auth) IsAuthStream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate validates the bearer token of the call and puts its claims
+// into the context, unless the method needs no authentication. Unary and
+// streaming calls both go through it and fail with Unauthenticated.
+func (a *Auth) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if authFreeMethods[method] {
+		return ctx, nil
 	}
 
 	md, ok := metadata.FromIncomingContext(ctx)
 
 	if !ok {
-		return nil, fmt.Errorf("Error get metadata from context")
+		return nil, status.Error(codes.Unauthenticated, "Error get metadata from context")
 	}
 
 	authHeader, ok := md["authorization"]
 
 	if !ok || len(authHeader) == 0 {
-		return nil, fmt.Errorf("Error get authorization header")
+		return nil, status.Error(codes.Unauthenticated, "Error get authorization header")
 	}
 
 	tokenString := strings.TrimPrefix(authHeader[0], "Bearer ")
 
 	if tokenString == authHeader[0] {
-		return nil, fmt.Errorf("Error get authorization header")
+		return nil, status.Error(codes.Unauthenticated, "Error get authorization header")
 	}
 
 	claims, err := jwt.ParseAccessToken(tokenString)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error parse token err: %w", err)
+		return nil, status.Errorf(codes.Unauthenticated, "Error parse token err: %s", err.Error())
+	}
+
+	active, err := a.sessions.SessionActive(ctx, claims.UserID, claims.SessionID)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error check session")
+	}
+
+	if !active {
+		return nil, status.Error(codes.Unauthenticated, "Session is closed")
 	}
 
 	ctx = context.WithValue(ctx, "user_id", claims.UserID)
@@ -55,5 +126,5 @@ func IsAuth(
 
 	ctx = context.WithValue(ctx, "device_id", claims.DeviceID)
 
-	return handler(ctx, req)
+	return ctx, nil
 }
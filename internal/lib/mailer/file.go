@@ -0,0 +1,44 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"server/internal/domain/model"
+	"time"
+)
+
+// File is a Mailer that writes every message into a file of its own in a
+// directory, in the format of an .eml file.
+type File struct {
+	dir string
+}
+
+func NewFile(dir string) (*File, error) {
+	const op = "mailer.file.new"
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &File{dir: dir}, nil
+}
+
+func (f *File) Send(_ context.Context, mail model.Mail) error {
+	const op = "mailer.file.send"
+
+	now := time.Now().UTC()
+
+	name := filepath.Join(f.dir, now.Format("20060102T150405Z")+"-"+uuid.NewString()+".eml")
+
+	message := fmt.Sprintf("To: %s\r\nSubject: %s\r\nDate: %s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s",
+		mail.To, mail.Subject, now.Format(time.RFC1123Z), mail.Body)
+
+	if err := os.WriteFile(name, []byte(message), 0o640); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
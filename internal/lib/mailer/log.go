@@ -0,0 +1,29 @@
+// Package mailer delivers mail to users. The implementations here are meant
+// for local runs until a real mail service is configured.
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"server/internal/domain/model"
+)
+
+// Log is a Mailer that writes the whole message, body included, to the
+// application log.
+type Log struct {
+	log *slog.Logger
+}
+
+func NewLog(log *slog.Logger) *Log {
+	return &Log{log: log}
+}
+
+func (l *Log) Send(_ context.Context, mail model.Mail) error {
+	l.log.Info("mail sent",
+		slog.String("to", mail.To),
+		slog.String("subject", mail.Subject),
+		slog.String("body", mail.Body),
+	)
+
+	return nil
+}
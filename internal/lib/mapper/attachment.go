@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"server/internal/domain/model"
+	"server/pkg/attachment"
+)
+
+func ToAttachmentResponse(model model.Attachment) *attachment.AttachmentData {
+	return &attachment.AttachmentData{
+		Id:          model.ID,
+		TaskId:      model.TaskID,
+		FileName:    model.FileName,
+		ContentType: model.ContentType,
+		Size:        model.Size,
+		Checksum:    model.Checksum,
+		Uploader:    ToUserResponse(model.Uploader),
+		CreatedAt:   timestamppb.New(model.CreatedAt),
+	}
+}
+
+func ToAttachmentsResponse(attachments []model.Attachment) []*attachment.AttachmentData {
+	var attachmentResponse []*attachment.AttachmentData
+	for _, a := range attachments {
+		attachmentResponse = append(attachmentResponse, ToAttachmentResponse(a))
+	}
+
+	return attachmentResponse
+}
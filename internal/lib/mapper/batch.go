@@ -0,0 +1,19 @@
+package mapper
+
+import (
+	"server/internal/domain/model"
+	"server/pkg/task"
+)
+
+func ToTaskBatchResultResponse(model model.TaskBatchResult) *task.TaskBatchResult {
+	r := &task.TaskBatchResult{
+		Status: task.TaskBatchStatus(model.Status),
+		TaskId: model.TaskID,
+	}
+
+	if model.Task != nil {
+		r.Task = ToTaskResponse(*model.Task)
+	}
+
+	return r
+}
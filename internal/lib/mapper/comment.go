@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"server/internal/domain/model"
+	"server/pkg/comment"
+)
+
+func ToCommentResponse(model model.Comment) *comment.CommentData {
+	c := &comment.CommentData{
+		Id:        model.ID,
+		TaskId:    model.TaskID,
+		Author:    ToUserResponse(model.Author),
+		Body:      model.Body,
+		CreatedAt: timestamppb.New(model.CreatedAt),
+	}
+
+	if model.UpdatedAt != nil {
+		c.UpdatedAt = timestamppb.New(*model.UpdatedAt)
+	}
+
+	return c
+}
+
+func ToCommentsResponse(comments []model.Comment) []*comment.CommentData {
+	var commentResponse []*comment.CommentData
+	for _, c := range comments {
+		commentResponse = append(commentResponse, ToCommentResponse(c))
+	}
+
+	return commentResponse
+}
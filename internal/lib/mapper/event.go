@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"server/internal/domain/model"
+	"server/pkg/task"
+)
+
+func ToTaskEventResponse(model model.TaskEvent) *task.TaskEventData {
+	e := &task.TaskEventData{
+		Id:        model.ID,
+		TaskId:    model.TaskID,
+		Actor:     ToUserResponse(model.Actor),
+		Kind:      string(model.Kind),
+		CreatedAt: timestamppb.New(model.CreatedAt),
+	}
+
+	for _, c := range model.Changes {
+		e.Changes = append(e.Changes, &task.FieldChangeData{Field: c.Field, Before: c.Before, After: c.After})
+	}
+
+	return e
+}
+
+func ToTaskEventsResponse(events []model.TaskEvent) []*task.TaskEventData {
+	var eventResponse []*task.TaskEventData
+	for _, e := range events {
+		eventResponse = append(eventResponse, ToTaskEventResponse(e))
+	}
+
+	return eventResponse
+}
+
+func ToTaskChangeResponse(model model.TaskChange) *task.TaskChangeData {
+	c := &task.TaskChangeData{
+		Sequence: model.Sequence,
+		Kind:     task.TaskChangeKind(model.Kind),
+		TaskId:   model.TaskID,
+	}
+
+	if model.Task != nil {
+		c.Task = ToTaskResponse(*model.Task)
+	}
+
+	return c
+}
@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"server/internal/domain/model"
+	"server/pkg/label"
+)
+
+func ToLabelResponse(model model.Label) *label.LabelData {
+	return &label.LabelData{
+		Id:    model.ID,
+		Name:  model.Name,
+		Color: model.Color,
+	}
+}
+
+func ToLabelsResponse(labels []model.Label) []*label.LabelData {
+	var labelResponse []*label.LabelData
+	for _, l := range labels {
+		labelResponse = append(labelResponse, ToLabelResponse(l))
+	}
+
+	return labelResponse
+}
@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"server/internal/domain/model"
+	"server/pkg/project"
+)
+
+func ToProjectResponse(model model.Project) *project.ProjectData {
+	return &project.ProjectData{
+		Id:          model.ID,
+		Name:        model.Name,
+		Description: model.Description,
+		CreatedAt:   timestamppb.New(model.CreatedAt),
+		Statuses:    ToStatusesResponse(model.Statuses),
+	}
+}
+
+func ToProjectsResponse(projects []model.Project) []*project.ProjectData {
+	var projectResponse []*project.ProjectData
+	for _, p := range projects {
+		projectResponse = append(projectResponse, ToProjectResponse(p))
+	}
+
+	return projectResponse
+}
@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"server/internal/domain/model"
+	"server/pkg/task"
+)
+
+func ToTaskSyncResultsResponse(results []model.TaskSyncResult) []*task.TaskSyncResult {
+	var resultResponse []*task.TaskSyncResult
+	for _, r := range results {
+		resultResponse = append(resultResponse, &task.TaskSyncResult{
+			ClientId:         r.ClientID,
+			TaskId:           r.TaskID,
+			Status:           task.TaskSyncStatus(r.Status),
+			OverriddenFields: r.Overridden,
+			Error:            r.Error,
+		})
+	}
+
+	return resultResponse
+}
+
+func ToSyncTasksResponse(results []model.TaskSyncResult, delta model.TaskDelta) *task.SyncTasksResponse {
+	return &task.SyncTasksResponse{
+		Results:        ToTaskSyncResultsResponse(results),
+		Tasks:          ToTasksResponse(delta.Tasks),
+		DeletedTaskIds: delta.Deleted,
+		SyncToken:      delta.SyncToken,
+		HasMore:        delta.HasMore,
+	}
+}
@@ -9,28 +9,57 @@ import (
 )
 
 func ToTaskResponse(model model.Task) *task.GetTaskResponse {
-	u := &user.UserData{
-		UserId:   model.User.ID,
-		Username: model.User.Name,
-		Login:    model.User.Login,
+	s := ToStatusResponse(model.Status)
+
+	t := &task.GetTaskResponse{
+		TaskId:             model.ID,
+		Title:              model.Title,
+		Body:               model.Body,
+		CreateAt:           timestamppb.New(model.CreatedAt),
+		User:               ToUserResponse(model.User),
+		Creator:            ToUserResponse(model.Creator),
+		Status:             s,
+		Priority:           task.TaskPriority(model.Priority),
+		Labels:             ToLabelsResponse(model.Labels),
+		ParentId:           model.ParentID,
+		ProjectId:          model.ProjectID,
+		WorkspaceId:        model.WorkspaceID,
+		Subtasks:           ToTasksResponse(model.Subtasks),
+		Checklist:          ToChecklistResponse(model.Checklist),
+		CompletionPercent:  int32(model.Progress),
+		CommentCount:       model.CommentCount,
+		Recurrence:         model.Recurrence.Rule,
+		RecurrenceTimezone: model.Recurrence.Timezone,
+		Revision:           model.Revision,
 	}
 
-	s := &status.StatusData{
-		Id:     model.Status.ID,
-		Status: model.Status.Status,
+	for _, a := range model.Assignees {
+		t.Assignees = append(t.Assignees, ToUserResponse(a))
 	}
 
-	t := &task.GetTaskResponse{
-		TaskId:   model.ID,
-		Title:    model.Title,
-		Body:     model.Body,
-		CreateAt: timestamppb.New(model.CreatedAt),
-		User:     u,
-		Status:   s,
+	if model.DueAt != nil {
+		t.DueAt = timestamppb.New(*model.DueAt)
+	}
+
+	if model.RemindAt != nil {
+		t.RemindAt = timestamppb.New(*model.RemindAt)
+	}
+
+	if model.DeletedAt != nil {
+		t.DeletedAt = timestamppb.New(*model.DeletedAt)
 	}
+
 	return t
 }
 
+func ToUserResponse(model model.TodosUser) *user.UserData {
+	return &user.UserData{
+		UserId:   model.ID,
+		Username: model.Name,
+		Login:    model.Login,
+	}
+}
+
 func ToTasksResponse(tasks []model.Task) []*task.GetTaskResponse {
 	var taskResponse []*task.GetTaskResponse
 	for _, t := range tasks {
@@ -39,3 +68,53 @@ func ToTasksResponse(tasks []model.Task) []*task.GetTaskResponse {
 
 	return taskResponse
 }
+
+func ToChecklistItemResponse(model model.ChecklistItem) *task.ChecklistItemData {
+	return &task.ChecklistItemData{
+		Id:       model.ID,
+		Text:     model.Text,
+		Done:     model.Done,
+		Position: model.Position,
+	}
+}
+
+func ToChecklistResponse(items []model.ChecklistItem) []*task.ChecklistItemData {
+	var itemResponse []*task.ChecklistItemData
+	for _, i := range items {
+		itemResponse = append(itemResponse, ToChecklistItemResponse(i))
+	}
+
+	return itemResponse
+}
+
+func ToStatusResponse(model model.Status) *status.StatusData {
+	return &status.StatusData{
+		Id:       model.ID,
+		Status:   model.Status,
+		Position: model.Position,
+		Custom:   model.UserID != 0,
+	}
+}
+
+func ToStatusesResponse(statuses []model.Status) []*status.StatusData {
+	var statusResponse []*status.StatusData
+	for _, s := range statuses {
+		statusResponse = append(statusResponse, ToStatusResponse(s))
+	}
+
+	return statusResponse
+}
+
+func ToSearchTasksResponse(results []model.TaskSearchResult) []*task.SearchTaskResult {
+	var searchResponse []*task.SearchTaskResult
+	for _, r := range results {
+		searchResponse = append(searchResponse, &task.SearchTaskResult{
+			Task:           ToTaskResponse(r.Task),
+			TitleHighlight: r.TitleHighlight,
+			BodySnippet:    r.BodySnippet,
+			Rank:           r.Rank,
+		})
+	}
+
+	return searchResponse
+}
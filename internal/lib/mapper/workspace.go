@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"server/internal/domain/model"
+	"server/pkg/workspace"
+)
+
+var roles = map[model.Role]workspace.WorkspaceRole{
+	model.RoleOwner:  workspace.WorkspaceRole_WORKSPACE_ROLE_OWNER,
+	model.RoleAdmin:  workspace.WorkspaceRole_WORKSPACE_ROLE_ADMIN,
+	model.RoleMember: workspace.WorkspaceRole_WORKSPACE_ROLE_MEMBER,
+	model.RoleViewer: workspace.WorkspaceRole_WORKSPACE_ROLE_VIEWER,
+}
+
+func ToWorkspaceRole(role model.Role) workspace.WorkspaceRole {
+	return roles[role]
+}
+
+// FromWorkspaceRole returns an empty role for values without a model counterpart.
+func FromWorkspaceRole(role workspace.WorkspaceRole) model.Role {
+	for r, w := range roles {
+		if w == role {
+			return r
+		}
+	}
+
+	return ""
+}
+
+func ToMemberResponse(model model.WorkspaceMember) *workspace.MemberData {
+	return &workspace.MemberData{
+		User: ToUserResponse(model.User),
+		Role: ToWorkspaceRole(model.Role),
+	}
+}
+
+func ToWorkspaceResponse(model model.Workspace) *workspace.WorkspaceData {
+	w := &workspace.WorkspaceData{
+		Id:        model.ID,
+		Name:      model.Name,
+		CreatedAt: timestamppb.New(model.CreatedAt),
+		Role:      ToWorkspaceRole(model.Role),
+	}
+
+	for _, m := range model.Members {
+		w.Members = append(w.Members, ToMemberResponse(m))
+	}
+
+	return w
+}
+
+func ToWorkspacesResponse(workspaces []model.Workspace) []*workspace.WorkspaceData {
+	var workspaceResponse []*workspace.WorkspaceData
+	for _, w := range workspaces {
+		workspaceResponse = append(workspaceResponse, ToWorkspaceResponse(w))
+	}
+
+	return workspaceResponse
+}
@@ -0,0 +1,35 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+	"server/internal/domain/model"
+	"time"
+)
+
+// Log is a Notifier that only writes reminders to the application log.
+// It is meant for local runs until a real delivery channel is configured.
+type Log struct {
+	log *slog.Logger
+}
+
+func NewLog(log *slog.Logger) *Log {
+	return &Log{log: log}
+}
+
+func (l *Log) Notify(_ context.Context, reminder model.Reminder) error {
+	attrs := []any{
+		slog.Int64("task_id", reminder.TaskID),
+		slog.Int64("user_id", reminder.UserID),
+		slog.String("title", reminder.Title),
+		slog.Time("remind_at", reminder.RemindAt),
+	}
+
+	if reminder.DueAt != nil {
+		attrs = append(attrs, slog.Time("due_at", reminder.DueAt.In(time.UTC)))
+	}
+
+	l.log.Info("task reminder", attrs...)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"server/internal/domain/model"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+func encode[T any](cursor T) (string, error) {
+	raw, err := json.Marshal(cursor)
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decode reads a token made by encode; valid rejects a cursor that parses but
+// could not have been issued.
+func decode[T any](token string, valid func(cursor T) bool) (T, error) {
+	var cursor, zero T
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+
+	if err != nil {
+		return zero, ErrInvalidPageToken
+	}
+
+	if err := json.Unmarshal(raw, &cursor); err != nil || !valid(cursor) {
+		return zero, ErrInvalidPageToken
+	}
+
+	return cursor, nil
+}
+
+func EncodeTaskCursor(cursor model.TaskCursor) (string, error) {
+	return encode(cursor)
+}
+
+func DecodeTaskCursor(token string) (model.TaskCursor, error) {
+	return decode(token, func(cursor model.TaskCursor) bool { return cursor.ID != 0 })
+}
+
+func EncodeCommentCursor(cursor model.CommentCursor) (string, error) {
+	return encode(cursor)
+}
+
+func DecodeCommentCursor(token string) (model.CommentCursor, error) {
+	return decode(token, func(cursor model.CommentCursor) bool { return cursor.ID != 0 })
+}
+
+func EncodeTaskEventCursor(cursor model.TaskEventCursor) (string, error) {
+	return encode(cursor)
+}
+
+func DecodeTaskEventCursor(token string) (model.TaskEventCursor, error) {
+	return decode(token, func(cursor model.TaskEventCursor) bool { return cursor.ID != 0 })
+}
+
+func EncodeSyncToken(token model.SyncToken) (string, error) {
+	return encode(token)
+}
+
+// DecodeSyncToken reads a token made by EncodeSyncToken; an empty token starts
+// from the very beginning.
+func DecodeSyncToken(token string) (model.SyncToken, error) {
+	if token == "" {
+		return model.SyncToken{}, nil
+	}
+
+	return decode(token, func(syncToken model.SyncToken) bool { return syncToken.Revision >= 0 })
+}
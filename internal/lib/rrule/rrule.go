@@ -0,0 +1,552 @@
+// Package rrule parses and evaluates the subset of iCalendar recurrence rules
+// (RFC 5545, section 3.3.10) used by recurring tasks: FREQ, INTERVAL, COUNT,
+// UNTIL, BYDAY, BYMONTHDAY, BYMONTH and WKST.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = map[string]Frequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// maxPeriods bounds the search for the next occurrence, so a rule that can
+// never match again (e.g. the 30th of February) ends instead of looping.
+const maxPeriods = 10000
+
+const untilLayout = "20060102T150405Z"
+
+// WeekdayNum is a BYDAY entry: the weekday and, within a month, its ordinal.
+// N is zero for every such weekday, 1 for the first, -1 for the last one.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	WeekStart  time.Weekday
+}
+
+// Parse reads a rule such as "FREQ=WEEKLY;BYDAY=MO,WE" or
+// "RRULE:FREQ=MONTHLY;BYDAY=-1FR".
+func Parse(s string) (Rule, error) {
+	rule := Rule{Interval: 1, WeekStart: time.Monday}
+
+	s = strings.TrimSpace(s)
+	if len(s) >= 6 && strings.EqualFold(s[:6], "RRULE:") {
+		s = s[6:]
+	}
+
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+
+		if !ok || value == "" {
+			return Rule{}, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+
+		key = strings.ToUpper(key)
+		value = strings.ToUpper(value)
+
+		if seen[key] {
+			return Rule{}, fmt.Errorf("%w: duplicate %s", ErrInvalidRule, key)
+		}
+		seen[key] = true
+
+		var err error
+
+		switch key {
+		case "FREQ":
+			freq, known := frequencies[value]
+			if !known {
+				return Rule{}, fmt.Errorf("%w: unsupported frequency %q", ErrInvalidRule, value)
+			}
+			rule.Freq = freq
+		case "INTERVAL":
+			rule.Interval, err = parseNumber(value, 1, 1000)
+		case "COUNT":
+			rule.Count, err = parseNumber(value, 1, 100000)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseList(value, -31, 31)
+		case "BYMONTH":
+			var months []int
+			months, err = parseList(value, 1, 12)
+			for _, month := range months {
+				rule.ByMonth = append(rule.ByMonth, time.Month(month))
+			}
+		case "WKST":
+			day, known := weekdays[value]
+			if !known {
+				return Rule{}, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRule, value)
+			}
+			rule.WeekStart = day
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported part %s", ErrInvalidRule, key)
+		}
+
+		if err != nil {
+			return Rule{}, fmt.Errorf("%w: %s: %s", ErrInvalidRule, key, err.Error())
+		}
+	}
+
+	if rule.Freq == 0 {
+		return Rule{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+
+	if rule.Count != 0 && !rule.Until.IsZero() {
+		return Rule{}, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRule)
+	}
+
+	for _, day := range rule.ByDay {
+		if day.N == 0 {
+			continue
+		}
+
+		// Ordinals count weekdays within a month; a yearly rule needs BYMONTH
+		// to say which month, the year-wide numbering is not supported.
+		if rule.Freq == Daily || rule.Freq == Weekly || rule.Freq == Yearly && len(rule.ByMonth) == 0 {
+			return Rule{}, fmt.Errorf("%w: BYDAY ordinals are not supported with this frequency", ErrInvalidRule)
+		}
+	}
+
+	if rule.Freq == Weekly && len(rule.ByMonthDay) > 0 {
+		return Rule{}, fmt.Errorf("%w: BYMONTHDAY is not allowed with FREQ=WEEKLY", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+// String formats the rule back into its canonical RRULE value.
+func (r Rule) String() string {
+	var parts []string
+
+	for name, freq := range frequencies {
+		if freq == r.Freq {
+			parts = append(parts, "FREQ="+name)
+		}
+	}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			name := weekdayName(day.Weekday)
+			if day.N != 0 {
+				name = strconv.Itoa(day.N) + name
+			}
+			days = append(days, name)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+
+	if len(r.ByMonth) > 0 {
+		months := make([]int, 0, len(r.ByMonth))
+		for _, month := range r.ByMonth {
+			months = append(months, int(month))
+		}
+		parts = append(parts, "BYMONTH="+joinInts(months))
+	}
+
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayName(r.WeekStart))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// After returns the first occurrence later than t of the series starting at
+// start, keeping its wall-clock time; false means the series has ended.
+func (r Rule) After(start time.Time, t time.Time) (time.Time, bool) {
+	hour, minute, second := start.Clock()
+	year, month, day := start.Date()
+	first := date(year, month, day)
+
+	// The start itself is the first occurrence of the series.
+	count := 1
+
+	ty, tm, td := t.In(start.Location()).Date()
+	last := date(ty, tm, td)
+
+	for period, ahead := 0, 0; ahead < maxPeriods; period++ {
+		if r.periodStart(first, period).After(last) {
+			ahead++
+		}
+
+		for _, candidate := range r.candidates(first, period) {
+			at := localTime(candidate, hour, minute, second, start.Nanosecond(), start.Location())
+
+			if !at.After(start) {
+				continue
+			}
+
+			if !r.Until.IsZero() && at.After(r.Until) {
+				return time.Time{}, false
+			}
+
+			count++
+
+			if r.Count > 0 && count > r.Count {
+				return time.Time{}, false
+			}
+
+			if at.After(t) {
+				return at, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// periodStart returns the first day of the given period of the series.
+func (r Rule) periodStart(first time.Time, period int) time.Time {
+	step := period * r.Interval
+
+	switch r.Freq {
+	case Weekly:
+		offset := (int(first.Weekday()) - int(r.WeekStart) + 7) % 7
+		return first.AddDate(0, 0, step*7-offset)
+	case Monthly:
+		return date(first.Year(), first.Month()+time.Month(step), 1)
+	case Yearly:
+		return date(first.Year()+step, time.January, 1)
+	}
+
+	return first.AddDate(0, 0, step)
+}
+
+// candidates lists, in order, the dates of the given period of the series,
+// counted in INTERVAL steps from the period of first.
+func (r Rule) candidates(first time.Time, period int) []time.Time {
+	step := period * r.Interval
+
+	var days []time.Time
+
+	switch r.Freq {
+	case Daily:
+		day := first.AddDate(0, 0, step)
+		if r.matchMonth(day.Month()) && r.matchMonthDay(day) && r.matchWeekday(day) {
+			days = append(days, day)
+		}
+	case Weekly:
+		weekStart := r.periodStart(first, period)
+
+		for i := 0; i < 7; i++ {
+			day := weekStart.AddDate(0, 0, i)
+
+			matched := day.Weekday() == first.Weekday()
+			if len(r.ByDay) > 0 {
+				matched = r.matchWeekday(day)
+			}
+
+			if matched && r.matchMonth(day.Month()) {
+				days = append(days, day)
+			}
+		}
+	case Monthly:
+		month := r.periodStart(first, period)
+		if r.matchMonth(month.Month()) {
+			days = r.monthDays(month, first.Day())
+		}
+	case Yearly:
+		year := first.Year() + step
+
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{first.Month()}
+			// A day filter without BYMONTH applies to every month of the year.
+			if len(r.ByDay) > 0 || len(r.ByMonthDay) > 0 {
+				months = []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			}
+		}
+
+		for _, month := range sortedMonths(months) {
+			days = append(days, r.monthDays(date(year, month, 1), first.Day())...)
+		}
+	}
+
+	return days
+}
+
+// monthDays lists the days of the month the rule selects; without day
+// filters that is the day of the first occurrence, skipped in months too
+// short to have it.
+func (r Rule) monthDays(month time.Time, firstDay int) []time.Time {
+	var days []time.Time
+
+	length := daysIn(month.Year(), month.Month())
+
+	for d := 1; d <= length; d++ {
+		day := date(month.Year(), month.Month(), d)
+
+		var matched bool
+
+		switch {
+		case len(r.ByMonthDay) > 0 && len(r.ByDay) > 0:
+			matched = r.matchMonthDay(day) && r.matchWeekday(day)
+		case len(r.ByMonthDay) > 0:
+			matched = r.matchMonthDay(day)
+		case len(r.ByDay) > 0:
+			matched = r.matchWeekday(day)
+		default:
+			matched = d == firstDay
+		}
+
+		if matched {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
+
+func (r Rule) matchMonth(month time.Month) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+
+	for _, m := range r.ByMonth {
+		if m == month {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchMonthDay checks BYMONTHDAY, where negative values count from the end
+// of the month: -1 is the last day.
+func (r Rule) matchMonthDay(day time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+
+	length := daysIn(day.Year(), day.Month())
+
+	for _, d := range r.ByMonthDay {
+		if d > 0 && d == day.Day() || d < 0 && length+d+1 == day.Day() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchWeekday checks BYDAY; ordinals are counted within the month.
+func (r Rule) matchWeekday(day time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+
+	length := daysIn(day.Year(), day.Month())
+	fromStart := (day.Day()-1)/7 + 1
+	fromEnd := -((length-day.Day())/7 + 1)
+
+	for _, wd := range r.ByDay {
+		if wd.Weekday != day.Weekday() {
+			continue
+		}
+
+		if wd.N == 0 || wd.N == fromStart || wd.N == fromEnd {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseNumber(value string, min int, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%q is out of range", value)
+	}
+
+	return n, nil
+}
+
+// parseList reads comma-separated numbers within [min, max], zero excluded.
+func parseList(value string, min int, max int) ([]int, error) {
+	var list []int
+
+	for _, item := range strings.Split(value, ",") {
+		n, err := parseNumber(strings.TrimPrefix(item, "+"), min, max)
+
+		if err != nil || n == 0 {
+			return nil, fmt.Errorf("%q is out of range", item)
+		}
+
+		list = append(list, n)
+	}
+
+	return list, nil
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("unknown weekday %q", item)
+		}
+
+		name, ordinal := item[len(item)-2:], item[:len(item)-2]
+
+		day, known := weekdays[name]
+
+		if !known {
+			return nil, fmt.Errorf("unknown weekday %q", item)
+		}
+
+		n := 0
+
+		if ordinal != "" {
+			var err error
+			n, err = parseNumber(strings.TrimPrefix(ordinal, "+"), -5, 5)
+
+			if err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid ordinal in %q", item)
+			}
+		}
+
+		days = append(days, WeekdayNum{Weekday: day, N: n})
+	}
+
+	return days, nil
+}
+
+// parseUntil accepts UTC and floating date-times, taking the latter as UTC,
+// and plain dates, which include the whole day.
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse(untilLayout, value); err == nil {
+		return until, nil
+	}
+
+	if until, err := time.Parse("20060102T150405", value); err == nil {
+		return until, nil
+	}
+
+	until, err := time.Parse("20060102", value)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	return until.Add(24*time.Hour - time.Second), nil
+}
+
+func weekdayName(day time.Weekday) string {
+	for name, wd := range weekdays {
+		if wd == day {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func joinInts(values []int) string {
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		items = append(items, strconv.Itoa(v))
+	}
+
+	return strings.Join(items, ",")
+}
+
+func sortedMonths(months []time.Month) []time.Month {
+	sorted := make([]time.Month, 0, len(months))
+
+	for m := time.January; m <= time.December; m++ {
+		for _, month := range months {
+			if month == m {
+				sorted = append(sorted, m)
+				break
+			}
+		}
+	}
+
+	return sorted
+}
+
+// localTime puts the wall-clock time on the day in loc. A time that falls
+// into a DST gap is read with the offset from before the gap, as RFC 5545
+// prescribes, so 02:30 on a spring-forward night becomes 03:30.
+func localTime(day time.Time, hour int, minute int, second int, nsec int, loc *time.Location) time.Time {
+	at := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, nsec, loc)
+
+	if h, m, _ := at.Clock(); h == hour && m == minute {
+		return at
+	}
+
+	_, before := at.Add(-12 * time.Hour).Zone()
+	wall := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, nsec, time.UTC)
+
+	return wall.Add(-time.Duration(before) * time.Second).In(loc)
+}
+
+// date returns midnight UTC of the day; calendar arithmetic is done in UTC so
+// that DST changes of the task's location cannot shift the days.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func daysIn(year int, month time.Month) int {
+	return date(year, month+1, 0).Day()
+}
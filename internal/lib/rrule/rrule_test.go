@@ -0,0 +1,81 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+const layout = "2006-01-02 15:04"
+
+func TestAfter(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start string
+		after string
+		// want is empty when the series has ended.
+		want string
+	}{
+		{"daily across spring forward", "FREQ=DAILY", "2024-03-09 09:00", "2024-03-09 09:00", "2024-03-10 09:00"},
+		{"daily across fall back", "FREQ=DAILY", "2024-11-02 09:00", "2024-11-02 09:00", "2024-11-03 09:00"},
+		{"daily into the gap", "FREQ=DAILY", "2024-03-09 02:30", "2024-03-09 02:30", "2024-03-10 03:30"},
+		{"daily after the gap", "FREQ=DAILY", "2024-03-09 02:30", "2024-03-10 03:30", "2024-03-11 02:30"},
+		{"weekly across spring forward", "FREQ=WEEKLY;BYDAY=MO", "2024-03-04 09:00", "2024-03-04 09:00", "2024-03-11 09:00"},
+		{"monthly across spring forward", "FREQ=MONTHLY", "2024-02-15 09:00", "2024-02-15 09:00", "2024-03-15 09:00"},
+		{"monthly on the 31st skips short months", "FREQ=MONTHLY;BYMONTHDAY=31", "2024-01-31 09:00", "2024-01-31 09:00", "2024-03-31 09:00"},
+		{"monthly on the 31st after march", "FREQ=MONTHLY;BYMONTHDAY=31", "2024-01-31 09:00", "2024-03-31 09:00", "2024-05-31 09:00"},
+		{"monthly from the 31st", "FREQ=MONTHLY", "2024-01-31 09:00", "2024-01-31 09:00", "2024-03-31 09:00"},
+		{"monthly on the 29th skips february", "FREQ=MONTHLY;BYMONTHDAY=29", "2023-01-29 09:00", "2023-01-29 09:00", "2023-03-29 09:00"},
+		{"monthly on the 29th in a leap year", "FREQ=MONTHLY;BYMONTHDAY=29", "2024-01-29 09:00", "2024-01-29 09:00", "2024-02-29 09:00"},
+		{"last day of february", "FREQ=MONTHLY;BYMONTHDAY=-1", "2023-01-31 09:00", "2023-01-31 09:00", "2023-02-28 09:00"},
+		{"last day of a leap february", "FREQ=MONTHLY;BYMONTHDAY=-1", "2024-01-31 09:00", "2024-01-31 09:00", "2024-02-29 09:00"},
+		{"yearly from 29 february", "FREQ=YEARLY", "2024-02-29 09:00", "2024-02-29 09:00", "2028-02-29 09:00"},
+		{"yearly on 29 february", "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29", "2024-02-29 09:00", "2025-01-01 00:00", "2028-02-29 09:00"},
+		{"count ends at month end", "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=2", "2024-01-31 09:00", "2024-03-31 09:00", ""},
+		{"until before the next month end", "FREQ=MONTHLY;BYMONTHDAY=31;UNTIL=20240430T000000Z", "2024-01-31 09:00", "2024-03-31 09:00", ""},
+		{"never again", "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", "2024-01-01 09:00", "2024-01-01 09:00", ""},
+	}
+
+	parse := func(t *testing.T, value string) time.Time {
+		at, err := time.ParseInLocation(layout, value, loc)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return at
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := rule.After(parse(t, tt.start), parse(t, tt.after))
+
+			if tt.want == "" {
+				if ok {
+					t.Fatalf("got %s, want the series to end", got.In(loc).Format(layout))
+				}
+				return
+			}
+
+			if !ok {
+				t.Fatalf("series ended, want %s", tt.want)
+			}
+
+			if want := parse(t, tt.want); !got.Equal(want) {
+				t.Fatalf("got %s, want %s", got.In(loc).Format(time.RFC3339), want.Format(time.RFC3339))
+			}
+		})
+	}
+}
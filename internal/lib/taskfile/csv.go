@@ -0,0 +1,185 @@
+package taskfile
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"server/internal/domain/model"
+	"strconv"
+	"strings"
+)
+
+var csvColumns = []string{"id", "parent_id", "title", "body", "status", "priority", "due_at", "remind_at",
+	"recurrence", "recurrence_timezone", "created_at"}
+
+// csvReader reads the CSV the exports write. Columns are found by the header,
+// so they may come in any order and only title is required.
+type csvReader struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCSVReader(r io.Reader) *csvReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	return &csvReader{r: reader}
+}
+
+func (c *csvReader) Read() (model.TaskRecord, error) {
+	if c.columns == nil {
+		header, err := c.r.Read()
+
+		if err != nil {
+			return model.TaskRecord{}, err
+		}
+
+		c.columns = headerColumns(header)
+
+		if _, ok := c.columns["title"]; !ok {
+			return model.TaskRecord{}, fmt.Errorf("%w: title", ErrMissingColumn)
+		}
+	}
+
+	fields, err := c.r.Read()
+
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return model.TaskRecord{}, &RowError{Row: parseErr.StartLine, Err: parseErr.Err}
+		}
+		return model.TaskRecord{}, err
+	}
+
+	row, _ := c.r.FieldPos(0)
+
+	record, err := c.record(fields)
+
+	if err != nil {
+		return model.TaskRecord{}, &RowError{Row: row, Err: err}
+	}
+
+	record.Row = row
+
+	return record, nil
+}
+
+func (c *csvReader) record(fields []string) (model.TaskRecord, error) {
+	field := func(name string) string {
+		i, ok := c.columns[name]
+		if !ok || i >= len(fields) {
+			return ""
+		}
+		return fields[i]
+	}
+
+	record := model.TaskRecord{
+		Title:              strings.TrimSpace(field("title")),
+		Body:               field("body"),
+		Status:             strings.TrimSpace(field("status")),
+		Recurrence:         strings.TrimSpace(field("recurrence")),
+		RecurrenceTimezone: strings.TrimSpace(field("recurrence_timezone")),
+	}
+
+	var err error
+
+	if record.ID, err = parseID(field("id"), "id"); err != nil {
+		return record, err
+	}
+
+	if record.ParentID, err = parseID(field("parent_id"), "parent_id"); err != nil {
+		return record, err
+	}
+
+	if record.Priority, err = parsePriority(field("priority")); err != nil {
+		return record, err
+	}
+
+	if record.DueAt, err = parseTime(field("due_at"), "due_at"); err != nil {
+		return record, err
+	}
+
+	if record.RemindAt, err = parseTime(field("remind_at"), "remind_at"); err != nil {
+		return record, err
+	}
+
+	if record.CreatedAt, err = parseTime(field("created_at"), "created_at"); err != nil {
+		return record, err
+	}
+
+	return record, checkRecord(record)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvColumns); err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{w: writer}, nil
+}
+
+func (c *csvWriter) Write(record model.TaskRecord) error {
+	return c.w.Write([]string{
+		formatID(record.ID),
+		formatID(record.ParentID),
+		record.Title,
+		record.Body,
+		record.Status,
+		formatPriority(record.Priority),
+		formatTime(record.DueAt),
+		formatTime(record.RemindAt),
+		record.Recurrence,
+		record.RecurrenceTimezone,
+		formatTime(record.CreatedAt),
+	})
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// headerColumns maps the lower-cased column names to their positions.
+func headerColumns(header []string) map[string]int {
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+
+	return columns
+}
+
+func parseID(value string, column string) (int64, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("%s: %q is not an id", column, value)
+	}
+
+	return id, nil
+}
+
+func formatID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(id, 10)
+}
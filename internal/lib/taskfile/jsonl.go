@@ -0,0 +1,82 @@
+package taskfile
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"server/internal/domain/model"
+	"strings"
+)
+
+// jsonRecord is a record as a JSON Lines row, with the priority by name.
+type jsonRecord struct {
+	model.TaskRecord
+	Priority string `json:"priority,omitempty"`
+}
+
+type jsonReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+func newJSONReader(r io.Reader) *jsonReader {
+	return &jsonReader{r: bufio.NewReader(r)}
+}
+
+func (j *jsonReader) Read() (model.TaskRecord, error) {
+	for {
+		line, err := j.r.ReadString('\n')
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			return model.TaskRecord{}, err
+		}
+
+		if line == "" && errors.Is(err, io.EOF) {
+			return model.TaskRecord{}, io.EOF
+		}
+
+		j.line++
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var row jsonRecord
+
+		if err := json.Unmarshal([]byte(line), &row); err != nil {
+			return model.TaskRecord{}, &RowError{Row: j.line, Err: err}
+		}
+
+		record := row.TaskRecord
+		record.Row = j.line
+
+		if record.Priority, err = parsePriority(row.Priority); err != nil {
+			return model.TaskRecord{}, &RowError{Row: j.line, Err: err}
+		}
+
+		if err := checkRecord(record); err != nil {
+			return model.TaskRecord{}, &RowError{Row: j.line, Err: err}
+		}
+
+		return record, nil
+	}
+}
+
+type jsonWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	buf := bufio.NewWriter(w)
+	return &jsonWriter{w: buf, enc: json.NewEncoder(buf)}
+}
+
+func (j *jsonWriter) Write(record model.TaskRecord) error {
+	return j.enc.Encode(jsonRecord{TaskRecord: record, Priority: formatPriority(record.Priority)})
+}
+
+func (j *jsonWriter) Flush() error {
+	return j.w.Flush()
+}
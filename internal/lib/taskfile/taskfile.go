@@ -0,0 +1,128 @@
+// Package taskfile reads and writes tasks in the file formats of imports and
+// exports.
+package taskfile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"server/internal/domain/model"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format")
+	ErrMissingColumn     = errors.New("required column is missing")
+)
+
+// RowError is a row that could not be read; the rows after it still can.
+type RowError struct {
+	Row int
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %s", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+type Reader interface {
+	// Read returns the next record, a *RowError for a broken row or io.EOF
+	// after the last one.
+	Read() (model.TaskRecord, error)
+}
+
+type Writer interface {
+	Write(record model.TaskRecord) error
+	Flush() error
+}
+
+func NewReader(r io.Reader, format model.TaskFileFormat) (Reader, error) {
+	switch format {
+	case model.TaskFileJSONLines:
+		return newJSONReader(r), nil
+	case model.TaskFileCSV:
+		return newCSVReader(r), nil
+	case model.TaskFileTodoistCSV:
+		return newTodoistReader(r), nil
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+func NewWriter(w io.Writer, format model.TaskFileFormat) (Writer, error) {
+	switch format {
+	case model.TaskFileJSONLines:
+		return newJSONWriter(w), nil
+	case model.TaskFileCSV:
+		return newCSVWriter(w)
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+var priorityNames = map[model.Priority]string{
+	model.PriorityLow:    "low",
+	model.PriorityMedium: "medium",
+	model.PriorityHigh:   "high",
+	model.PriorityUrgent: "urgent",
+}
+
+func formatPriority(priority model.Priority) string {
+	return priorityNames[priority]
+}
+
+func parsePriority(name string) (model.Priority, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if name == "" {
+		return model.PriorityUnspecified, nil
+	}
+
+	for priority, n := range priorityNames {
+		if n == name {
+			return priority, nil
+		}
+	}
+
+	return model.PriorityUnspecified, fmt.Errorf("unknown priority %q", name)
+}
+
+func formatTime(at *time.Time) string {
+	if at == nil {
+		return ""
+	}
+
+	return at.UTC().Format(time.RFC3339)
+}
+
+func parseTime(value string, column string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return nil, nil
+	}
+
+	at, err := time.Parse(time.RFC3339, value)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %q is not an RFC 3339 time", column, value)
+	}
+
+	at = at.UTC()
+
+	return &at, nil
+}
+
+// checkRecord holds what every format demands of a row.
+func checkRecord(record model.TaskRecord) error {
+	if strings.TrimSpace(record.Title) == "" {
+		return errors.New("title is required")
+	}
+
+	return nil
+}
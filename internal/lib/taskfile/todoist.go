@@ -0,0 +1,207 @@
+package taskfile
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"server/internal/domain/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// todoistPriorities maps the PRIORITY column of the export, where 1 is the
+// most urgent task and 4 one without priority.
+var todoistPriorities = map[string]model.Priority{
+	"":  model.PriorityUnspecified,
+	"1": model.PriorityUrgent,
+	"2": model.PriorityHigh,
+	"3": model.PriorityMedium,
+	"4": model.PriorityUnspecified,
+}
+
+var todoistDateLayouts = []string{"2006-01-02", "2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02T15:04:05"}
+
+// todoistReader reads a Todoist CSV export; tasks nest by their INDENT and
+// notes go into the body of the task above them.
+type todoistReader struct {
+	r       *csv.Reader
+	columns map[string]int
+	// parents holds the ids of the last task read on every indent level.
+	parents []int64
+	// pending is the last task read, held back for the notes that follow it.
+	pending *model.TaskRecord
+	err     error
+}
+
+func newTodoistReader(r io.Reader) *todoistReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	return &todoistReader{r: reader}
+}
+
+func (t *todoistReader) Read() (model.TaskRecord, error) {
+	if t.columns == nil {
+		header, err := t.r.Read()
+
+		if err != nil {
+			return model.TaskRecord{}, err
+		}
+
+		t.columns = headerColumns(header)
+
+		for _, column := range []string{"type", "content"} {
+			if _, ok := t.columns[column]; !ok {
+				return model.TaskRecord{}, fmt.Errorf("%w: %s", ErrMissingColumn, strings.ToUpper(column))
+			}
+		}
+	}
+
+	for {
+		if t.err != nil {
+			// A broken row waits until the task before it has been handed out.
+			if t.pending != nil {
+				return t.release(), nil
+			}
+			err := t.err
+			t.err = nil
+			return model.TaskRecord{}, err
+		}
+
+		fields, err := t.r.Read()
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				t.err = &RowError{Row: parseErr.StartLine, Err: parseErr.Err}
+				continue
+			}
+
+			if errors.Is(err, io.EOF) && t.pending != nil {
+				return t.release(), nil
+			}
+
+			return model.TaskRecord{}, err
+		}
+
+		row, _ := t.r.FieldPos(0)
+
+		field := func(name string) string {
+			i, ok := t.columns[name]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[i])
+		}
+
+		switch strings.ToLower(field("type")) {
+		case "task":
+			record, err := t.record(row, field)
+
+			if err != nil {
+				t.err = &RowError{Row: row, Err: err}
+				continue
+			}
+
+			previous := t.pending
+			t.pending = &record
+
+			if previous != nil {
+				return *previous, nil
+			}
+
+		case "note":
+			if t.pending != nil && field("content") != "" {
+				if t.pending.Body != "" {
+					t.pending.Body += "\n\n"
+				}
+				t.pending.Body += field("content")
+			}
+		}
+	}
+}
+
+func (t *todoistReader) record(row int, field func(name string) string) (model.TaskRecord, error) {
+	record := model.TaskRecord{
+		Row:   row,
+		ID:    int64(row),
+		Title: field("content"),
+		Body:  field("description"),
+	}
+
+	priority, ok := todoistPriorities[field("priority")]
+
+	if !ok {
+		return record, fmt.Errorf("unknown priority %q", field("priority"))
+	}
+
+	record.Priority = priority
+
+	indent := 1
+
+	if value := field("indent"); value != "" {
+		n, err := strconv.Atoi(value)
+
+		if err != nil || n < 1 {
+			return record, fmt.Errorf("indent: %q is not a level", value)
+		}
+
+		indent = n
+	}
+
+	if indent > len(t.parents)+1 {
+		return record, fmt.Errorf("indent %d has no parent task", indent)
+	}
+
+	if indent > 1 {
+		record.ParentID = t.parents[indent-2]
+	}
+
+	t.parents = append(t.parents[:indent-1], record.ID)
+
+	location := time.UTC
+
+	if name := field("timezone"); name != "" {
+		loc, err := time.LoadLocation(name)
+
+		if err != nil {
+			return record, fmt.Errorf("unknown time zone %q", name)
+		}
+
+		location = loc
+	}
+
+	if value := field("date"); value != "" {
+		due, err := parseTodoistDate(value, location)
+
+		if err != nil {
+			return record, err
+		}
+
+		record.DueAt = &due
+	}
+
+	return record, checkRecord(record)
+}
+
+func (t *todoistReader) release() model.TaskRecord {
+	record := *t.pending
+	t.pending = nil
+	return record
+}
+
+func parseTodoistDate(value string, location *time.Location) (time.Time, error) {
+	for _, layout := range todoistDateLayouts {
+		if at, err := time.ParseInLocation(layout, value, location); err == nil {
+			return at.UTC(), nil
+		}
+	}
+
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at.UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("date %q is not in ISO form", value)
+}
@@ -0,0 +1,285 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"server/internal/blobstore"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"strings"
+	"time"
+)
+
+var (
+	ErrAttachmentNotFound    = errors.New("attachment not found")
+	ErrAttachmentTooLarge    = errors.New("attachment is too large")
+	ErrContentTypeNotAllowed = errors.New("content type is not allowed")
+	ErrInvalidFileName       = errors.New("invalid file name")
+	ErrTaskNotFound          = errors.New("task not found")
+	ErrTaskAccessDenied      = errors.New("access to the task is denied")
+)
+
+// sniffLen is how much of the file http.DetectContentType looks at.
+const sniffLen = 512
+
+type Attachment struct {
+	log                *slog.Logger
+	saverAttachment    SaverAttachment
+	providerAttachment ProviderAttachment
+	removerAttachment  RemoverAttachment
+	blobs              BlobStore
+	maxSize            int64
+	contentTypes       []string
+}
+
+func New(
+	log *slog.Logger,
+	saverAttachment SaverAttachment,
+	providerAttachment ProviderAttachment,
+	removerAttachment RemoverAttachment,
+	blobs BlobStore,
+	maxSize int64,
+	contentTypes []string,
+) *Attachment {
+	return &Attachment{
+		log:                log,
+		saverAttachment:    saverAttachment,
+		providerAttachment: providerAttachment,
+		removerAttachment:  removerAttachment,
+		blobs:              blobs,
+		maxSize:            maxSize,
+		contentTypes:       contentTypes,
+	}
+}
+
+type SaverAttachment interface {
+	CheckTaskWritable(ctx context.Context, taskID int64, userID int64) error
+	SaveAttachment(ctx context.Context, attachment model.Attachment) (int64, error)
+}
+
+type ProviderAttachment interface {
+	GetAttachment(ctx context.Context, attachmentID int64, userID int64) (model.Attachment, error)
+	GetAttachments(ctx context.Context, taskID int64, userID int64) ([]model.Attachment, error)
+}
+
+type RemoverAttachment interface {
+	RemoveAttachment(ctx context.Context, attachmentID int64, userID int64) (string, error)
+}
+
+// BlobStore keeps the file contents; the metadata stays in the storage.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// UploadAttachment streams the file from r into the blob store, enforcing the
+// size limit on the way and computing its SHA-256 checksum.
+func (a *Attachment) UploadAttachment(ctx context.Context, attachment model.Attachment, r io.Reader) (model.Attachment, error) {
+	const op = "attachment.upload"
+
+	log := a.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Attachment{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	fileName := filepath.Base(filepath.Clean("/" + attachment.FileName))
+
+	if fileName == "/" || fileName == "." {
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, ErrInvalidFileName)
+	}
+
+	if err := a.saverAttachment.CheckTaskWritable(ctx, attachment.TaskID, userID); err != nil {
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	// The declared type is up to the client, so the allowed list is checked
+	// against the type sniffed from the contents instead.
+	head := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(r, head)
+
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	head = head[:n]
+
+	contentType, err := a.contentType(http.DetectContentType(head))
+
+	if err != nil {
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	key := uuid.NewString()
+	hash := sha256.New()
+
+	// One byte over the limit is enough to tell the file is too large.
+	size, err := a.blobs.Put(ctx, key, io.TeeReader(io.LimitReader(io.MultiReader(bytes.NewReader(head), r), a.maxSize+1), hash))
+
+	if err != nil {
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if size > a.maxSize {
+		a.deleteBlob(ctx, log, key)
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, ErrAttachmentTooLarge)
+	}
+
+	attachment = model.Attachment{
+		TaskID:      attachment.TaskID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		BlobKey:     key,
+		Uploader:    model.TodosUser{ID: userID},
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	id, err := a.saverAttachment.SaveAttachment(ctx, attachment)
+
+	if err != nil {
+		log.Warn("failed to save attachment", slog.String("error", err.Error()))
+		a.deleteBlob(ctx, log, key)
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	saved, err := a.providerAttachment.GetAttachment(ctx, id, userID)
+
+	if err != nil {
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return saved, nil
+}
+
+// DownloadAttachment returns the attachment metadata and a reader of its
+// contents, which the caller has to close.
+func (a *Attachment) DownloadAttachment(ctx context.Context, attachmentID int64) (model.Attachment, io.ReadCloser, error) {
+	const op = "attachment.download"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Attachment{}, nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	attachment, err := a.providerAttachment.GetAttachment(ctx, attachmentID, userID)
+
+	if err != nil {
+		return model.Attachment{}, nil, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	r, err := a.blobs.Open(ctx, attachment.BlobKey)
+
+	if err != nil {
+		if errors.Is(err, blobstore.ErrBlobNotFound) {
+			a.log.Error("attachment blob is missing", slog.Int64("attachment_id", attachmentID), slog.String("op", op))
+			return model.Attachment{}, nil, fmt.Errorf("%s: %w", op, ErrAttachmentNotFound)
+		}
+		return model.Attachment{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attachment, r, nil
+}
+
+func (a *Attachment) FetchAttachments(ctx context.Context, taskID int64) ([]model.Attachment, error) {
+	const op = "attachment.fetch_all"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	attachments, err := a.providerAttachment.GetAttachments(ctx, taskID, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return attachments, nil
+}
+
+func (a *Attachment) DeleteAttachment(ctx context.Context, attachmentID int64) error {
+	const op = "attachment.delete"
+
+	log := a.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	key, err := a.removerAttachment.RemoveAttachment(ctx, attachmentID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	a.deleteBlob(ctx, log, key)
+
+	return nil
+}
+
+// contentType normalizes the sniffed media type and checks it against the
+// allowed list; an empty list allows any type.
+func (a *Attachment) contentType(sniffed string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(sniffed)
+
+	if err != nil {
+		return "", ErrContentTypeNotAllowed
+	}
+
+	if len(a.contentTypes) == 0 {
+		return mediaType, nil
+	}
+
+	for _, allowed := range a.contentTypes {
+		if allowed == mediaType {
+			return mediaType, nil
+		}
+
+		if family, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, family+"/") {
+			return mediaType, nil
+		}
+	}
+
+	return "", ErrContentTypeNotAllowed
+}
+
+// deleteBlob drops a blob whose metadata is gone or was never saved; a failure
+// only leaves an orphaned file behind, so it is logged rather than returned.
+func (a *Attachment) deleteBlob(ctx context.Context, log *slog.Logger, key string) {
+	if err := a.blobs.Delete(context.WithoutCancel(ctx), key); err != nil {
+		log.Warn("failed to delete blob", slog.String("key", key), slog.String("error", err.Error()))
+	}
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrAttachmentNotFound):
+		return ErrAttachmentNotFound
+	case errors.Is(err, storage.ErrTaskNotFound):
+		return ErrTaskNotFound
+	case errors.Is(err, storage.ErrTaskAccessDenied):
+		return ErrTaskAccessDenied
+	}
+
+	return err
+}
@@ -0,0 +1,262 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/lib/ical"
+	"server/internal/storage"
+	"strconv"
+	"time"
+)
+
+var ErrFeedNotFound = errors.New("calendar feed not found")
+
+const (
+	prodID   = "-//TickTask//Tasks//EN"
+	feedName = "TickTask"
+	// maxFeedTasks bounds the size of a calendar; the tasks due first win.
+	maxFeedTasks = 1000
+	tokenBytes   = 32
+	// FeedPath is where the HTTP server serves a feed, by its token.
+	FeedPath = "/calendar/%s.ics"
+)
+
+type Calendar struct {
+	log          *slog.Logger
+	saverFeed    SaverFeed
+	providerFeed ProviderFeed
+	providerTask ProviderTask
+	workflow     Workflow
+}
+
+func New(
+	log *slog.Logger,
+	saverFeed SaverFeed,
+	providerFeed ProviderFeed,
+	providerTask ProviderTask,
+	workflow Workflow,
+) *Calendar {
+	return &Calendar{
+		log:          log,
+		saverFeed:    saverFeed,
+		providerFeed: providerFeed,
+		providerTask: providerTask,
+		workflow:     workflow,
+	}
+}
+
+type SaverFeed interface {
+	SaveCalendarFeed(ctx context.Context, userID int64, tokenHash string, createdAt time.Time) error
+	RemoveCalendarFeed(ctx context.Context, userID int64) error
+}
+
+type ProviderFeed interface {
+	GetCalendarFeedUser(ctx context.Context, tokenHash string) (int64, error)
+}
+
+type ProviderTask interface {
+	ListDueTasks(ctx context.Context, userID int64, limit int) ([]model.Task, error)
+}
+
+// Workflow names the statuses the calendar maps onto VTODO statuses.
+type Workflow interface {
+	Initial() string
+	Closed() []string
+	Completed() string
+}
+
+// CreateFeed issues a new feed token for the user; a feed created before
+// stops working.
+func (c *Calendar) CreateFeed(ctx context.Context) (model.CalendarFeed, error) {
+	const op = "calendar.create_feed"
+
+	log := c.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.CalendarFeed{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	raw := make([]byte, tokenBytes)
+
+	if _, err := rand.Read(raw); err != nil {
+		return model.CalendarFeed{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	feed := model.CalendarFeed{Token: token, Path: fmt.Sprintf(FeedPath, token), CreatedAt: time.Now().UTC()}
+
+	if err := c.saverFeed.SaveCalendarFeed(ctx, userID, hashToken(feed.Token), feed.CreatedAt); err != nil {
+		log.Error("failed to save calendar feed", slog.String("error", err.Error()))
+		return model.CalendarFeed{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("calendar feed created", slog.Int64("user_id", userID))
+
+	return feed, nil
+}
+
+func (c *Calendar) RevokeFeed(ctx context.Context) error {
+	const op = "calendar.revoke_feed"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := c.saverFeed.RemoveCalendarFeed(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return nil
+}
+
+// ExportCalendar returns the iCalendar file of the tasks with a due date.
+func (c *Calendar) ExportCalendar(ctx context.Context) ([]byte, error) {
+	const op = "calendar.export"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	data, err := c.render(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return data, nil
+}
+
+// FeedCalendar is ExportCalendar for the owner of the feed token; it needs
+// no signed-in user, as calendar apps poll the feed on their own.
+func (c *Calendar) FeedCalendar(ctx context.Context, token string) ([]byte, error) {
+	const op = "calendar.feed"
+
+	if token == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrFeedNotFound)
+	}
+
+	userID, err := c.providerFeed.GetCalendarFeedUser(ctx, hashToken(token))
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	data, err := c.render(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return data, nil
+}
+
+func (c *Calendar) render(ctx context.Context, userID int64) ([]byte, error) {
+	tasks, err := c.providerTask.ListDueTasks(ctx, userID, maxFeedTasks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	calendar := ical.Calendar{ProdID: prodID, Name: feedName, Todos: make([]ical.Todo, 0, len(tasks))}
+
+	for _, task := range tasks {
+		calendar.Todos = append(calendar.Todos, c.todo(task, now))
+	}
+
+	var buf bytes.Buffer
+
+	if err := ical.Encode(&buf, calendar); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *Calendar) todo(task model.Task, now time.Time) ical.Todo {
+	todo := ical.Todo{
+		UID:         "task-" + strconv.FormatInt(task.ID, 10) + "@ticktask",
+		Summary:     task.Title,
+		Description: task.Body,
+		Stamp:       now,
+		Created:     task.CreatedAt,
+		Due:         task.DueAt,
+		Status:      c.status(task.Status.Status),
+		Priority:    priority(task.Priority),
+	}
+
+	// Only the open occurrence of a series carries the rule, anchored at its
+	// due date; the calendar app expands the following ones from there. The
+	// times are written in UTC, so a rule evaluated in another timezone would
+	// drift across its DST changes and is left out.
+	if task.Recurrence.Rule != "" && task.DueAt != nil && (task.Recurrence.Timezone == "" || task.Recurrence.Timezone == "UTC") {
+		todo.RRule = task.Recurrence.Rule
+		todo.Start = task.DueAt
+	}
+
+	return todo
+}
+
+func (c *Calendar) status(name string) ical.Status {
+	if name == c.workflow.Completed() {
+		return ical.StatusCompleted
+	}
+
+	for _, closed := range c.workflow.Closed() {
+		if name == closed {
+			return ical.StatusCancelled
+		}
+	}
+
+	if name == c.workflow.Initial() {
+		return ical.StatusNeedsAction
+	}
+
+	return ical.StatusInProcess
+}
+
+// priority maps onto the iCalendar scale, where 1 is the highest and 9 the
+// lowest priority.
+func priority(priority model.Priority) int {
+	switch priority {
+	case model.PriorityUrgent:
+		return 1
+	case model.PriorityHigh:
+		return 3
+	case model.PriorityMedium:
+		return 5
+	case model.PriorityLow:
+		return 9
+	}
+
+	return 0
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func storageError(err error) error {
+	if errors.Is(err, storage.ErrCalendarFeedNotFound) {
+		return ErrFeedNotFound
+	}
+
+	return err
+}
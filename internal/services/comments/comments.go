@@ -0,0 +1,206 @@
+package comments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/lib/pagination"
+	"server/internal/storage"
+	"time"
+)
+
+var (
+	ErrCommentNotFound     = errors.New("comment not found")
+	ErrCommentAccessDenied = errors.New("comment belongs to another user")
+	ErrTaskNotFound        = errors.New("task not found")
+	ErrTaskAccessDenied    = errors.New("access to the task is denied")
+	ErrInvalidPageToken    = errors.New("invalid page token")
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+type Comment struct {
+	log             *slog.Logger
+	saverComment    SaverComment
+	providerComment ProviderComment
+	updaterComment  UpdaterComment
+	removerComment  RemoverComment
+}
+
+func New(
+	log *slog.Logger,
+	saverComment SaverComment,
+	providerComment ProviderComment,
+	updaterComment UpdaterComment,
+	removerComment RemoverComment,
+) *Comment {
+	return &Comment{
+		log:             log,
+		saverComment:    saverComment,
+		providerComment: providerComment,
+		updaterComment:  updaterComment,
+		removerComment:  removerComment,
+	}
+}
+
+type SaverComment interface {
+	SaveComment(ctx context.Context, comment model.Comment) (int64, error)
+}
+
+type ProviderComment interface {
+	GetComment(ctx context.Context, commentID int64, userID int64) (model.Comment, error)
+	GetComments(ctx context.Context, taskID int64, userID int64, after *model.CommentCursor, limit int) ([]model.Comment, error)
+}
+
+type UpdaterComment interface {
+	UpdateComment(ctx context.Context, commentID int64, userID int64, body string, updatedAt time.Time) error
+}
+
+type RemoverComment interface {
+	RemoveComment(ctx context.Context, commentID int64, userID int64) error
+}
+
+func (c *Comment) AddComment(ctx context.Context, taskID int64, body string) (model.Comment, error) {
+	const op = "comment.add"
+
+	log := c.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Comment{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	id, err := c.saverComment.SaveComment(ctx, model.Comment{
+		TaskID:    taskID,
+		Author:    model.TodosUser{ID: userID},
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	})
+
+	if err != nil {
+		log.Warn("failed to save comment", slog.String("error", err.Error()))
+		return model.Comment{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	comment, err := c.providerComment.GetComment(ctx, id, userID)
+
+	if err != nil {
+		return model.Comment{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return comment, nil
+}
+
+// FetchComments returns a page of the task comments, oldest first, and the
+// token of the next page, which is empty on the last one.
+func (c *Comment) FetchComments(ctx context.Context, taskID int64, pageSize int, pageToken string) ([]model.Comment, string, error) {
+	const op = "comment.fetch_all"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, "", fmt.Errorf("Not found user_id in context")
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var after *model.CommentCursor
+
+	if pageToken != "" {
+		cursor, err := pagination.DecodeCommentCursor(pageToken)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, ErrInvalidPageToken)
+		}
+
+		after = &cursor
+	}
+
+	// One extra row tells whether another page exists.
+	comments, err := c.providerComment.GetComments(ctx, taskID, userID, after, pageSize+1)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if len(comments) <= pageSize {
+		return comments, "", nil
+	}
+
+	comments = comments[:pageSize]
+
+	last := comments[pageSize-1]
+
+	nextPageToken, err := pagination.EncodeCommentCursor(model.CommentCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return comments, nextPageToken, nil
+}
+
+func (c *Comment) EditComment(ctx context.Context, commentID int64, body string) (model.Comment, error) {
+	const op = "comment.edit"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Comment{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := c.updaterComment.UpdateComment(ctx, commentID, userID, body, time.Now().UTC()); err != nil {
+		return model.Comment{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	comment, err := c.providerComment.GetComment(ctx, commentID, userID)
+
+	if err != nil {
+		return model.Comment{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return comment, nil
+}
+
+func (c *Comment) DeleteComment(ctx context.Context, commentID int64) error {
+	const op = "comment.delete"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := c.removerComment.RemoveComment(ctx, commentID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return nil
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrCommentNotFound):
+		return ErrCommentNotFound
+	case errors.Is(err, storage.ErrCommentAccessDenied):
+		return ErrCommentAccessDenied
+	case errors.Is(err, storage.ErrTaskNotFound):
+		return ErrTaskNotFound
+	case errors.Is(err, storage.ErrTaskAccessDenied):
+		return ErrTaskAccessDenied
+	}
+
+	return err
+}
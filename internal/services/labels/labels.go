@@ -0,0 +1,137 @@
+package labels
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/storage"
+)
+
+var (
+	ErrLabelNotFound    = errors.New("label not found")
+	ErrLabelExists      = errors.New("label already exists")
+	ErrTaskNotFound     = errors.New("task not found")
+	ErrTaskAccessDenied = errors.New("access to the task is denied")
+)
+
+type Label struct {
+	log           *slog.Logger
+	saverLabel    SaverLabel
+	providerLabel ProviderLabel
+	linkerLabel   LinkerLabel
+}
+
+func New(
+	log *slog.Logger,
+	saverLabel SaverLabel,
+	providerLabel ProviderLabel,
+	linkerLabel LinkerLabel,
+) *Label {
+	return &Label{
+		log:           log,
+		saverLabel:    saverLabel,
+		providerLabel: providerLabel,
+		linkerLabel:   linkerLabel,
+	}
+}
+
+type SaverLabel interface {
+	SaveLabel(ctx context.Context, label model.Label) (int64, error)
+}
+
+type ProviderLabel interface {
+	GetLabels(ctx context.Context, userID int64) ([]model.Label, error)
+}
+
+type LinkerLabel interface {
+	AttachLabel(ctx context.Context, taskID int64, labelID int64, userID int64) error
+	DetachLabel(ctx context.Context, taskID int64, labelID int64, userID int64) error
+}
+
+func (l *Label) CreateLabel(ctx context.Context, name string, color string) (int64, error) {
+	const op = "label.create"
+
+	log := l.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return 0, fmt.Errorf("Not found user_id in context")
+	}
+
+	id, err := l.saverLabel.SaveLabel(ctx, model.Label{Name: name, Color: color, UserID: userID})
+
+	if err != nil {
+		log.Warn("failed to save label", slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return id, nil
+}
+
+func (l *Label) FetchLabels(ctx context.Context) ([]model.Label, error) {
+	const op = "label.fetch_all"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	labels, err := l.providerLabel.GetLabels(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return labels, nil
+}
+
+func (l *Label) AttachLabel(ctx context.Context, taskID int64, labelID int64) error {
+	const op = "label.attach"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := l.linkerLabel.AttachLabel(ctx, taskID, labelID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return nil
+}
+
+func (l *Label) DetachLabel(ctx context.Context, taskID int64, labelID int64) error {
+	const op = "label.detach"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := l.linkerLabel.DetachLabel(ctx, taskID, labelID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return nil
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrLabelNotFound):
+		return ErrLabelNotFound
+	case errors.Is(err, storage.ErrLabelExist):
+		return ErrLabelExists
+	case errors.Is(err, storage.ErrTaskNotFound):
+		return ErrTaskNotFound
+	case errors.Is(err, storage.ErrTaskAccessDenied):
+		return ErrTaskAccessDenied
+	}
+
+	return err
+}
@@ -0,0 +1,235 @@
+package projects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+var (
+	ErrProjectNotFound     = errors.New("project not found")
+	ErrProjectExists       = errors.New("project already exists")
+	ErrProjectAccessDenied = errors.New("project belongs to another user")
+	ErrStatusNotFound      = errors.New("status not found")
+)
+
+type Project struct {
+	log             *slog.Logger
+	saverProject    SaverProject
+	providerProject ProviderProject
+	updaterProject  UpdaterProject
+	removerProject  RemoverProject
+	providerStatus  ProviderStatus
+	initialStatus   string
+}
+
+func New(
+	log *slog.Logger,
+	saverProject SaverProject,
+	providerProject ProviderProject,
+	updaterProject UpdaterProject,
+	removerProject RemoverProject,
+	providerStatus ProviderStatus,
+	initialStatus string,
+) *Project {
+	return &Project{
+		log:             log,
+		saverProject:    saverProject,
+		providerProject: providerProject,
+		updaterProject:  updaterProject,
+		removerProject:  removerProject,
+		providerStatus:  providerStatus,
+		initialStatus:   initialStatus,
+	}
+}
+
+type SaverProject interface {
+	SaveProject(ctx context.Context, project model.Project, statusIDs []int64) (int64, error)
+}
+
+type ProviderProject interface {
+	GetProject(ctx context.Context, projectID int64, userID int64) (model.Project, error)
+	GetProjects(ctx context.Context, userID int64) ([]model.Project, error)
+}
+
+type UpdaterProject interface {
+	UpdateProject(ctx context.Context, projectID int64, userID int64, project model.UpdateProject) error
+}
+
+type RemoverProject interface {
+	RemoveProject(ctx context.Context, projectID int64, userID int64) error
+}
+
+type ProviderStatus interface {
+	GetStatuses(ctx context.Context, userID int64) ([]model.Status, error)
+	GetStatusByID(ctx context.Context, statusID int64, userID int64) (model.Status, error)
+	GetStatusByName(ctx context.Context, name string) (model.Status, error)
+}
+
+// CreateProject stores a project with the given status set. Without statusIDs
+// the project gets all shared statuses; the initial workflow status is always
+// part of the set so new tasks can be created in the project.
+func (p *Project) CreateProject(ctx context.Context, name string, description string, statusIDs []int64) (int64, error) {
+	const op = "project.create"
+
+	log := p.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return 0, fmt.Errorf("Not found user_id in context")
+	}
+
+	statusIDs, err := p.statusSet(ctx, userID, statusIDs)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	project := model.Project{
+		Name:        name,
+		Description: description,
+		CreatedAt:   time.Now().UTC(),
+		UserID:      userID,
+	}
+
+	id, err := p.saverProject.SaveProject(ctx, project, statusIDs)
+
+	if err != nil {
+		log.Warn("failed to save project", slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return id, nil
+}
+
+func (p *Project) FetchProject(ctx context.Context, projectID int64) (model.Project, error) {
+	const op = "project.fetch"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Project{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	project, err := p.providerProject.GetProject(ctx, projectID, userID)
+
+	if err != nil {
+		return model.Project{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return project, nil
+}
+
+func (p *Project) FetchProjects(ctx context.Context) ([]model.Project, error) {
+	const op = "project.fetch_all"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	projects, err := p.providerProject.GetProjects(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return projects, nil
+}
+
+func (p *Project) UpdateProject(ctx context.Context, projectID int64, project model.UpdateProject) (model.Project, error) {
+	const op = "project.update"
+
+	log := p.log.With(slog.String("op", op), slog.Int64("project_id", projectID))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Project{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	err := p.updaterProject.UpdateProject(ctx, projectID, userID, project)
+
+	if err != nil {
+		log.Warn("failed to update project", slog.String("error", err.Error()))
+		return model.Project{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	updated, err := p.providerProject.GetProject(ctx, projectID, userID)
+
+	if err != nil {
+		return model.Project{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return updated, nil
+}
+
+func (p *Project) RemoveProject(ctx context.Context, projectID int64) error {
+	const op = "project.remove"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := p.removerProject.RemoveProject(ctx, projectID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return nil
+}
+
+func (p *Project) statusSet(ctx context.Context, userID int64, statusIDs []int64) ([]int64, error) {
+	if len(statusIDs) == 0 {
+		statuses, err := p.providerStatus.GetStatuses(ctx, userID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, status := range statuses {
+			if status.UserID == 0 {
+				statusIDs = append(statusIDs, status.ID)
+			}
+		}
+
+		return statusIDs, nil
+	}
+
+	for _, statusID := range statusIDs {
+		if _, err := p.providerStatus.GetStatusByID(ctx, statusID, userID); err != nil {
+			if errors.Is(err, storage.ErrStatusNotFound) {
+				return nil, ErrStatusNotFound
+			}
+			return nil, err
+		}
+	}
+
+	initial, err := p.providerStatus.GetStatusByName(ctx, p.initialStatus)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(statusIDs, initial.ID), nil
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrProjectNotFound):
+		return ErrProjectNotFound
+	case errors.Is(err, storage.ErrProjectExist):
+		return ErrProjectExists
+	case errors.Is(err, storage.ErrProjectAccessDenied):
+		return ErrProjectAccessDenied
+	}
+
+	return err
+}
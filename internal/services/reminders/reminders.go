@@ -0,0 +1,137 @@
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"time"
+)
+
+// Scheduler periodically hands the reminders that have come due to a
+// Notifier, retrying failed deliveries with a backoff.
+type Scheduler struct {
+	log              *slog.Logger
+	providerReminder ProviderReminder
+	notifier         Notifier
+	interval         time.Duration
+	batchSize        int
+	stop             chan struct{}
+	done             chan struct{}
+}
+
+func New(
+	log *slog.Logger,
+	providerReminder ProviderReminder,
+	notifier Notifier,
+	interval time.Duration,
+	batchSize int,
+) *Scheduler {
+	return &Scheduler{
+		log:              log,
+		providerReminder: providerReminder,
+		notifier:         notifier,
+		interval:         interval,
+		batchSize:        batchSize,
+		stop:             make(chan struct{}),
+		done:             make(chan struct{}),
+	}
+}
+
+type ProviderReminder interface {
+	DueReminders(ctx context.Context, now time.Time, limit int) ([]model.Reminder, error)
+	MarkReminded(ctx context.Context, taskID int64, remindAt time.Time, at time.Time) error
+	DeferReminder(ctx context.Context, taskID int64, remindAt time.Time, retryAt time.Time) error
+}
+
+// maxRetryBackoff caps the wait between two deliveries of a failing reminder.
+const maxRetryBackoff = 24 * time.Hour
+
+type Notifier interface {
+	Notify(ctx context.Context, reminder model.Reminder) error
+}
+
+func (s *Scheduler) Run() {
+	const op = "reminders.run"
+
+	log := s.log.With(slog.String("op", op))
+
+	log.Info("starting reminder scheduler", slog.Duration("interval", s.interval))
+
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(context.Background(), time.Now().UTC()); err != nil {
+			log.Error("failed to process reminders", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick delivers every reminder due at now, one batch at a time.
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) error {
+	const op = "reminders.tick"
+
+	log := s.log.With(slog.String("op", op))
+
+	for {
+		due, err := s.providerReminder.DueReminders(ctx, now, s.batchSize)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, reminder := range due {
+			if err := s.notifier.Notify(ctx, reminder); err != nil {
+				retryAt := now.Add(s.backoff(reminder.Attempts))
+
+				log.Warn("failed to deliver reminder", slog.Int64("task_id", reminder.TaskID), slog.Time("retry_at", retryAt),
+					slog.String("error", err.Error()))
+
+				// Deferred, it leaves the next batch to the reminders behind it.
+				if err := s.providerReminder.DeferReminder(ctx, reminder.TaskID, reminder.RemindAt, retryAt); err != nil {
+					return fmt.Errorf("%s: %w", op, err)
+				}
+				continue
+			}
+
+			if err := s.providerReminder.MarkReminded(ctx, reminder.TaskID, reminder.RemindAt, now); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if len(due) < s.batchSize {
+			return nil
+		}
+	}
+}
+
+// backoff returns how long to wait after the given number of failed
+// deliveries: the interval, doubled with each earlier failure.
+func (s *Scheduler) backoff(attempts int) time.Duration {
+	wait := s.interval
+
+	for i := 0; i < attempts && wait < maxRetryBackoff; i++ {
+		wait *= 2
+	}
+
+	return min(wait, maxRetryBackoff)
+}
+
+func (s *Scheduler) Stop() {
+	const op = "reminders.stop"
+
+	s.log.With(slog.String("op", op)).Info("stopping reminder scheduler")
+
+	close(s.stop)
+
+	<-s.done
+}
@@ -0,0 +1,157 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"server/internal/domain/model"
+	"sort"
+	"testing"
+	"time"
+)
+
+// memoryReminders keeps the reminders of the test in memory, due ones in
+// order, the way the storage hands them out.
+type memoryReminders struct {
+	reminders map[int64]*memoryReminder
+}
+
+type memoryReminder struct {
+	model.Reminder
+	retryAt  time.Time
+	reminded bool
+}
+
+func (m *memoryReminders) DueReminders(ctx context.Context, now time.Time, limit int) ([]model.Reminder, error) {
+	var due []model.Reminder
+
+	for _, r := range m.reminders {
+		if !r.reminded && !r.RemindAt.After(now) && !r.retryAt.After(now) {
+			due = append(due, r.Reminder)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool { return due[i].TaskID < due[j].TaskID })
+
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	return due, nil
+}
+
+func (m *memoryReminders) MarkReminded(ctx context.Context, taskID int64, remindAt time.Time, at time.Time) error {
+	m.reminders[taskID].reminded = true
+	return nil
+}
+
+func (m *memoryReminders) DeferReminder(ctx context.Context, taskID int64, remindAt time.Time, retryAt time.Time) error {
+	r := m.reminders[taskID]
+	r.Attempts++
+	r.retryAt = retryAt
+	return nil
+}
+
+// failingNotifier fails the deliveries of the given tasks.
+type failingNotifier struct {
+	failing   map[int64]bool
+	delivered []int64
+}
+
+func (n *failingNotifier) Notify(ctx context.Context, reminder model.Reminder) error {
+	if n.failing[reminder.TaskID] {
+		return errors.New("mailbox unavailable")
+	}
+
+	n.delivered = append(n.delivered, reminder.TaskID)
+
+	return nil
+}
+
+func TestTickRetries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	interval := time.Minute
+	batchSize := 2
+
+	store := &memoryReminders{reminders: map[int64]*memoryReminder{}}
+
+	for id := int64(1); id <= 5; id++ {
+		store.reminders[id] = &memoryReminder{Reminder: model.Reminder{TaskID: id, RemindAt: now.Add(-time.Hour)}}
+	}
+
+	// A whole batch of failing reminders goes first.
+	notifier := &failingNotifier{failing: map[int64]bool{1: true, 2: true}}
+
+	scheduler := New(slog.New(slog.NewTextHandler(io.Discard, nil)), store, notifier, interval, batchSize)
+
+	if err := scheduler.Tick(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(notifier.delivered) != 3 {
+		t.Fatalf("delivered %v, want the reminders behind the failing ones", notifier.delivered)
+	}
+
+	for _, id := range []int64{1, 2} {
+		r := store.reminders[id]
+
+		if r.reminded || r.Attempts != 1 || !r.retryAt.Equal(now.Add(interval)) {
+			t.Fatalf("reminder %d: reminded %v, attempts %d, retry at %v", id, r.reminded, r.Attempts, r.retryAt)
+		}
+	}
+
+	// Not yet due again, the failing reminders are left alone.
+	if err := scheduler.Tick(ctx, now.Add(interval/2)); err != nil {
+		t.Fatal(err)
+	}
+
+	if store.reminders[1].Attempts != 1 {
+		t.Fatalf("attempts = %d, retried before the backoff ran out", store.reminders[1].Attempts)
+	}
+
+	// Failing again, the wait doubles.
+	later := now.Add(interval)
+
+	if err := scheduler.Tick(ctx, later); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := store.reminders[1]; r.Attempts != 2 || !r.retryAt.Equal(later.Add(2*interval)) {
+		t.Fatalf("attempts %d, retry at %v, want 2 and %v", r.Attempts, r.retryAt, later.Add(2*interval))
+	}
+
+	// Once the notifier recovers, the retry goes through.
+	notifier.failing = nil
+
+	if err := scheduler.Tick(ctx, later.Add(2*interval)); err != nil {
+		t.Fatal(err)
+	}
+
+	for id, r := range store.reminders {
+		if !r.reminded {
+			t.Fatalf("reminder %d is still pending", id)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	scheduler := &Scheduler{interval: time.Hour}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Hour},
+		{1, 2 * time.Hour},
+		{3, 8 * time.Hour},
+		{10, maxRetryBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := scheduler.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package statuses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"strings"
+)
+
+var (
+	ErrStatusNotFound     = errors.New("status not found")
+	ErrStatusExists       = errors.New("status already exists")
+	ErrStatusAccessDenied = errors.New("status is not owned by user")
+	ErrInvalidFallback    = errors.New("fallback status must differ from the deleted one")
+)
+
+type Status struct {
+	log            *slog.Logger
+	saverStatus    SaverStatus
+	providerStatus ProviderStatus
+	updaterStatus  UpdaterStatus
+	removerStatus  RemoverStatus
+	fallbackStatus string
+}
+
+func New(
+	log *slog.Logger,
+	saverStatus SaverStatus,
+	providerStatus ProviderStatus,
+	updaterStatus UpdaterStatus,
+	removerStatus RemoverStatus,
+	fallbackStatus string,
+) *Status {
+	return &Status{
+		log:            log,
+		saverStatus:    saverStatus,
+		providerStatus: providerStatus,
+		updaterStatus:  updaterStatus,
+		removerStatus:  removerStatus,
+		fallbackStatus: fallbackStatus,
+	}
+}
+
+type SaverStatus interface {
+	SaveStatus(ctx context.Context, userID int64, name string) (int64, error)
+}
+
+type ProviderStatus interface {
+	GetStatuses(ctx context.Context, userID int64) ([]model.Status, error)
+	GetStatusByID(ctx context.Context, statusID int64, userID int64) (model.Status, error)
+	GetStatusByName(ctx context.Context, name string) (model.Status, error)
+}
+
+type UpdaterStatus interface {
+	RenameStatus(ctx context.Context, statusID int64, userID int64, name string) error
+	ReorderStatuses(ctx context.Context, userID int64, statusIDs []int64) error
+}
+
+type RemoverStatus interface {
+	RemoveStatus(ctx context.Context, statusID int64, userID int64, fallbackStatusID int64) error
+}
+
+func (s *Status) CreateStatus(ctx context.Context, name string) (int64, error) {
+	const op = "status.create"
+
+	log := s.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return 0, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := s.checkNameFree(ctx, userID, name, 0); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.saverStatus.SaveStatus(ctx, userID, name)
+
+	if err != nil {
+		log.Warn("failed to save status", slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return id, nil
+}
+
+func (s *Status) FetchStatuses(ctx context.Context) ([]model.Status, error) {
+	const op = "status.fetch_all"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	statuses, err := s.providerStatus.GetStatuses(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statuses, nil
+}
+
+func (s *Status) RenameStatus(ctx context.Context, statusID int64, name string) (model.Status, error) {
+	const op = "status.rename"
+
+	log := s.log.With(slog.String("op", op), slog.Int64("status_id", statusID))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Status{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := s.checkNameFree(ctx, userID, name, statusID); err != nil {
+		return model.Status{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.updaterStatus.RenameStatus(ctx, statusID, userID, name)
+
+	if err != nil {
+		log.Warn("failed to rename status", slog.String("error", err.Error()))
+		return model.Status{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	status, err := s.providerStatus.GetStatusByID(ctx, statusID, userID)
+
+	if err != nil {
+		return model.Status{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return status, nil
+}
+
+func (s *Status) ReorderStatuses(ctx context.Context, statusIDs []int64) ([]model.Status, error) {
+	const op = "status.reorder"
+
+	log := s.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	err := s.updaterStatus.ReorderStatuses(ctx, userID, statusIDs)
+
+	if err != nil {
+		log.Warn("failed to reorder statuses", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	statuses, err := s.providerStatus.GetStatuses(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statuses, nil
+}
+
+func (s *Status) RemoveStatus(ctx context.Context, statusID int64, fallbackStatusID int64) error {
+	const op = "status.remove"
+
+	log := s.log.With(slog.String("op", op), slog.Int64("status_id", statusID))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	if fallbackStatusID == 0 {
+		fallback, err := s.providerStatus.GetStatusByName(ctx, s.fallbackStatus)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		fallbackStatusID = fallback.ID
+	} else if _, err := s.providerStatus.GetStatusByID(ctx, fallbackStatusID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if fallbackStatusID == statusID {
+		return fmt.Errorf("%s: %w", op, ErrInvalidFallback)
+	}
+
+	err := s.removerStatus.RemoveStatus(ctx, statusID, userID, fallbackStatusID)
+
+	if err != nil {
+		log.Warn("failed to remove status", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return nil
+}
+
+// checkNameFree rejects names already used by a shared status or another
+// status of the same user, ignoring case.
+func (s *Status) checkNameFree(ctx context.Context, userID int64, name string, statusID int64) error {
+	statuses, err := s.providerStatus.GetStatuses(ctx, userID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		if status.ID != statusID && strings.EqualFold(status.Status, name) {
+			return ErrStatusExists
+		}
+	}
+
+	return nil
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrStatusNotFound):
+		return ErrStatusNotFound
+	case errors.Is(err, storage.ErrStatusExist):
+		return ErrStatusExists
+	case errors.Is(err, storage.ErrStatusAccessDenied):
+		return ErrStatusAccessDenied
+	}
+
+	return err
+}
@@ -0,0 +1,160 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+)
+
+var ErrUnknownBatchAction = errors.New("unknown batch action")
+
+// errBatchAborted rolls an atomic batch back once one of its operations failed.
+var errBatchAborted = errors.New("batch aborted")
+
+// TxTask runs a function in one transaction shared by the storage calls made
+// with the context it gets; nested calls run in savepoints.
+type TxTask interface {
+	Atomically(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// atomically runs fn in one transaction and publishes its events once the
+// outermost transaction has committed.
+func atomically(ctx context.Context, txTask TxTask, hub Hub, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(publicationsKey{}).(*[]publication); ok {
+		return txTask.Atomically(ctx, fn)
+	}
+
+	var pending []publication
+
+	if err := txTask.Atomically(context.WithValue(ctx, publicationsKey{}, &pending), fn); err != nil {
+		return err
+	}
+
+	for _, p := range pending {
+		hub.Publish(p.event, p.audience)
+	}
+
+	return nil
+}
+
+// BatchTasks runs the operations in order in one transaction. An atomic batch
+// applies all of them or none; otherwise each one succeeds or fails alone.
+func (t *Task) BatchTasks(ctx context.Context, operations []model.TaskBatchOperation, atomic bool) ([]model.TaskBatchResult, error) {
+	const op = "tasks.batch"
+
+	log := t.log.With(slog.String("op", op))
+
+	if _, ok := ctx.Value("user_id").(int64); !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	results := make([]model.TaskBatchResult, len(operations))
+
+	// Watchers hear of the changes once they are committed.
+	var published []publication
+
+	err := t.txTask.Atomically(ctx, func(ctx context.Context) error {
+		for i, operation := range operations {
+			var pending []publication
+
+			itemCtx := context.WithValue(ctx, publicationsKey{}, &pending)
+
+			err := t.txTask.Atomically(itemCtx, func(ctx context.Context) error {
+				result, err := t.applyBatchOperation(ctx, operation)
+				results[i] = result
+				return err
+			})
+
+			if err != nil {
+				results[i] = model.TaskBatchResult{Status: model.TaskBatchFailed, TaskID: operation.TaskID, Err: err}
+
+				if atomic {
+					return errBatchAborted
+				}
+				continue
+			}
+
+			results[i].Status = model.TaskBatchApplied
+			published = append(published, pending...)
+		}
+
+		return nil
+	})
+
+	if errors.Is(err, errBatchAborted) {
+		for i := range results {
+			if results[i].Status != model.TaskBatchFailed {
+				results[i] = model.TaskBatchResult{Status: model.TaskBatchAborted, TaskID: operations[i].TaskID}
+			}
+		}
+
+		log.Info("atomic batch rolled back", slog.Int("operations", len(operations)))
+
+		return results, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, p := range published {
+		t.hub.Publish(p.event, p.audience)
+	}
+
+	return results, nil
+}
+
+// applyBatchOperation goes through the same service methods as the single
+// calls, so the batch checks and records the changes the same way.
+func (t *Task) applyBatchOperation(ctx context.Context, operation model.TaskBatchOperation) (model.TaskBatchResult, error) {
+	result := model.TaskBatchResult{TaskID: operation.TaskID}
+
+	switch operation.Action {
+	case model.TaskBatchCreate:
+		id, err := t.CreateTask(ctx, operation.Task)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.TaskID = id
+
+		task, err := t.FetchTask(ctx, id)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.Task = &task
+
+	case model.TaskBatchUpdate:
+		task, err := t.UpdateTask(ctx, operation.TaskID, operation.Update)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.Task = &task
+
+	case model.TaskBatchChangeStatus:
+		task, err := t.ChangeTaskStatus(ctx, operation.TaskID, operation.StatusID)
+
+		if err != nil {
+			return result, err
+		}
+
+		result.Task = &task
+
+	case model.TaskBatchDelete:
+		if err := t.RemoveTask(ctx, operation.TaskID, operation.Cascade); err != nil {
+			return result, err
+		}
+
+	default:
+		return result, ErrUnknownBatchAction
+	}
+
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package tasks_test
+
+import (
+	"server/internal/domain/model"
+	"slices"
+	"testing"
+)
+
+func TestBatchTasksAtomic(t *testing.T) {
+	s := newTestService(t)
+
+	userID, ctx := s.user(t, "owner")
+
+	taskID, err := s.tasks.CreateTask(ctx, model.RequestTask{Title: "existing"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inProgress := s.status(t, ctx, "in progress")
+
+	operations := []model.TaskBatchOperation{
+		{Action: model.TaskBatchCreate, Task: model.RequestTask{Title: "created"}},
+		{Action: model.TaskBatchChangeStatus, TaskID: taskID, StatusID: inProgress},
+		{Action: model.TaskBatchDelete, TaskID: taskID + 1000},
+	}
+
+	statuses := func(results []model.TaskBatchResult) []model.TaskBatchStatus {
+		var statuses []model.TaskBatchStatus
+		for _, result := range results {
+			statuses = append(statuses, result.Status)
+		}
+		return statuses
+	}
+
+	titles := func(t *testing.T) []string {
+		t.Helper()
+
+		tasks, _, err := s.tasks.FetchTasks(ctx, model.TaskFilter{}, "")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var titles []string
+		for _, task := range tasks {
+			titles = append(titles, task.Title)
+		}
+		return titles
+	}
+
+	t.Run("atomic batch rolls back every operation", func(t *testing.T) {
+		before, err := s.events.ListUserTaskEvents(ctx, userID, 0, 100)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		results, err := s.tasks.BatchTasks(ctx, operations, true)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []model.TaskBatchStatus{model.TaskBatchAborted, model.TaskBatchAborted, model.TaskBatchFailed}
+
+		if got := statuses(results); !slices.Equal(got, want) {
+			t.Fatalf("got statuses %v, want %v", got, want)
+		}
+
+		if got := titles(t); !slices.Equal(got, []string{"existing"}) {
+			t.Fatalf("got tasks %v after the rollback", got)
+		}
+
+		task, err := s.tasks.FetchTask(ctx, taskID)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if task.Status.ID == inProgress {
+			t.Fatal("status change survived the rollback")
+		}
+
+		after, err := s.events.ListUserTaskEvents(ctx, userID, 0, 100)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(after) != len(before) {
+			t.Fatalf("rollback left %d history events", len(after)-len(before))
+		}
+	})
+
+	t.Run("non-atomic batch keeps the operations that succeeded", func(t *testing.T) {
+		results, err := s.tasks.BatchTasks(ctx, operations, false)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []model.TaskBatchStatus{model.TaskBatchApplied, model.TaskBatchApplied, model.TaskBatchFailed}
+
+		if got := statuses(results); !slices.Equal(got, want) {
+			t.Fatalf("got statuses %v, want %v", got, want)
+		}
+
+		if got := titles(t); !slices.Contains(got, "created") {
+			t.Fatalf("got tasks %v, want the created one", got)
+		}
+
+		task, err := s.tasks.FetchTask(ctx, taskID)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if task.Status.ID != inProgress {
+			t.Fatalf("got status %d, want %d", task.Status.ID, inProgress)
+		}
+	})
+}
@@ -0,0 +1,486 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/lib/pagination"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type EventTask interface {
+	SaveTaskEvent(ctx context.Context, event model.TaskEvent) (int64, error)
+	GetTaskEvents(ctx context.Context, taskID int64, userID int64, after *model.TaskEventCursor, limit int) ([]model.TaskEvent, error)
+	ListUserTaskEvents(ctx context.Context, userID int64, afterID int64, limit int) ([]model.TaskEvent, error)
+	LastTaskEventID(ctx context.Context) (int64, error)
+	TaskAudience(ctx context.Context, taskID int64) ([]int64, error)
+}
+
+// FetchTaskHistory returns a page of the task history, oldest first, and the
+// token of the next page, which is empty on the last one.
+func (t *Task) FetchTaskHistory(ctx context.Context, taskID int64, pageSize int, pageToken string) ([]model.TaskEvent, string, error) {
+	const op = "task.fetch_history"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, "", fmt.Errorf("Not found user_id in context")
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var after *model.TaskEventCursor
+
+	if pageToken != "" {
+		cursor, err := pagination.DecodeTaskEventCursor(pageToken)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, ErrInvalidPageToken)
+		}
+
+		after = &cursor
+	}
+
+	// One extra row tells whether another page exists.
+	events, err := t.eventTask.GetTaskEvents(ctx, taskID, userID, after, pageSize+1)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if len(events) <= pageSize {
+		return events, "", nil
+	}
+
+	events = events[:pageSize]
+
+	nextPageToken, err := pagination.EncodeTaskEventCursor(model.TaskEventCursor{ID: events[pageSize-1].ID})
+
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nextPageToken, nil
+}
+
+// recorder wraps the task storage and writes a history entry for every
+// change, in the transaction of the change.
+type recorder struct {
+	log           *slog.Logger
+	eventTask     EventTask
+	hub           Hub
+	txTask        TxTask
+	providerTask  ProviderTask
+	saverTask     SaverTask
+	removerTask   RemoverTask
+	trashTask     TrashTask
+	updaterTask   UpdaterTask
+	checklistTask ChecklistTask
+}
+
+func (r *recorder) SaveTask(ctx context.Context, task model.RequestTask) (int64, error) {
+	var id int64
+
+	err := atomically(ctx, r.txTask, r.hub, func(ctx context.Context) error {
+		var err error
+
+		id, err = r.saverTask.SaveTask(ctx, task)
+
+		if err != nil {
+			return err
+		}
+
+		created, err := r.providerTask.GetTaskByID(ctx, id, task.UserID)
+
+		if err != nil {
+			return err
+		}
+
+		return r.record(ctx, id, task.CreatorID, model.TaskCreated, diffTask(nil, &created), nil)
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *recorder) UpdateTask(ctx context.Context, taskID int64, userID int64, task model.UpdateTask) error {
+	return r.change(ctx, taskID, userID, model.TaskUpdated, func(ctx context.Context) error {
+		return r.updaterTask.UpdateTask(ctx, taskID, userID, task)
+	})
+}
+
+func (r *recorder) MoveTask(ctx context.Context, taskID int64, userID int64, projectID int64) error {
+	return r.change(ctx, taskID, userID, model.TaskMoved, func(ctx context.Context) error {
+		return r.updaterTask.MoveTask(ctx, taskID, userID, projectID)
+	})
+}
+
+func (r *recorder) AssignTask(ctx context.Context, taskID int64, assigneeID int64, userID int64) error {
+	return r.change(ctx, taskID, userID, model.TaskAssigned, func(ctx context.Context) error {
+		return r.updaterTask.AssignTask(ctx, taskID, assigneeID, userID)
+	})
+}
+
+func (r *recorder) UnassignTask(ctx context.Context, taskID int64, assigneeID int64, userID int64) error {
+	return r.change(ctx, taskID, userID, model.TaskUnassigned, func(ctx context.Context) error {
+		return r.updaterTask.UnassignTask(ctx, taskID, assigneeID, userID)
+	})
+}
+
+// Remove records a deletion for every task that goes into the trash, so the
+// subtasks removed with cascade tell who deleted them as well.
+func (r *recorder) Remove(ctx context.Context, taskID int64, userID int64, cascade bool, deletedAt time.Time) error {
+	return atomically(ctx, r.txTask, r.hub, func(ctx context.Context) error {
+		taskIDs := []int64{taskID}
+
+		if cascade {
+			for i := 0; i < len(taskIDs); i++ {
+				subtasks, err := r.providerTask.GetSubtasks(ctx, taskIDs[i], userID)
+
+				if err != nil {
+					return err
+				}
+
+				for _, subtask := range subtasks {
+					taskIDs = append(taskIDs, subtask.ID)
+				}
+			}
+		}
+
+		if err := r.removerTask.Remove(ctx, taskID, userID, cascade, deletedAt); err != nil {
+			return err
+		}
+
+		changes := []model.FieldChange{{Field: "deleted_at", After: formatTime(&deletedAt)}}
+
+		for _, id := range taskIDs {
+			if err := r.record(ctx, id, userID, model.TaskDeleted, changes, nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *recorder) ListTrash(ctx context.Context, userID int64, limit int) ([]model.Task, error) {
+	return r.trashTask.ListTrash(ctx, userID, limit)
+}
+
+func (r *recorder) RestoreTask(ctx context.Context, taskID int64, userID int64) error {
+	return atomically(ctx, r.txTask, r.hub, func(ctx context.Context) error {
+		if err := r.trashTask.RestoreTask(ctx, taskID, userID); err != nil {
+			return err
+		}
+
+		return r.record(ctx, taskID, userID, model.TaskRestored, nil, nil)
+	})
+}
+
+// PurgeTask works out who watches the task while it still exists.
+func (r *recorder) PurgeTask(ctx context.Context, taskID int64, userID int64) ([]string, error) {
+	var blobKeys []string
+
+	err := atomically(ctx, r.txTask, r.hub, func(ctx context.Context) error {
+		audience, err := r.eventTask.TaskAudience(ctx, taskID)
+
+		if err != nil {
+			return err
+		}
+
+		blobKeys, err = r.trashTask.PurgeTask(ctx, taskID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		return r.record(ctx, taskID, userID, model.TaskPurged, nil, audience)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blobKeys, nil
+}
+
+func (r *recorder) ExpiredTrash(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	return r.trashTask.ExpiredTrash(ctx, before, limit)
+}
+
+// PurgeExpiredTask records the purge with no actor, the retention did it.
+func (r *recorder) PurgeExpiredTask(ctx context.Context, taskID int64) ([]string, error) {
+	var blobKeys []string
+
+	err := atomically(ctx, r.txTask, r.hub, func(ctx context.Context) error {
+		audience, err := r.eventTask.TaskAudience(ctx, taskID)
+
+		if err != nil {
+			return err
+		}
+
+		blobKeys, err = r.trashTask.PurgeExpiredTask(ctx, taskID)
+
+		if err != nil {
+			return err
+		}
+
+		return r.record(ctx, taskID, 0, model.TaskPurged, nil, audience)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blobKeys, nil
+}
+
+func (r *recorder) SaveChecklistItem(ctx context.Context, taskID int64, userID int64, text string) (model.ChecklistItem, error) {
+	var item model.ChecklistItem
+
+	err := r.changeChecklist(ctx, taskID, userID, func(ctx context.Context) error {
+		var err error
+		item, err = r.checklistTask.SaveChecklistItem(ctx, taskID, userID, text)
+		return err
+	})
+
+	return item, err
+}
+
+func (r *recorder) GetChecklist(ctx context.Context, taskID int64, userID int64) ([]model.ChecklistItem, error) {
+	return r.checklistTask.GetChecklist(ctx, taskID, userID)
+}
+
+func (r *recorder) ToggleChecklistItem(ctx context.Context, taskID int64, itemID int64, userID int64, done bool) (model.ChecklistItem, error) {
+	var item model.ChecklistItem
+
+	err := r.changeChecklist(ctx, taskID, userID, func(ctx context.Context) error {
+		var err error
+		item, err = r.checklistTask.ToggleChecklistItem(ctx, taskID, itemID, userID, done)
+		return err
+	})
+
+	return item, err
+}
+
+func (r *recorder) ReorderChecklistItems(ctx context.Context, taskID int64, userID int64, itemIDs []int64) error {
+	return r.changeChecklist(ctx, taskID, userID, func(ctx context.Context) error {
+		return r.checklistTask.ReorderChecklistItems(ctx, taskID, userID, itemIDs)
+	})
+}
+
+// change applies a change to the task and records the fields it touched. A
+// status change is told apart from other updates, since that is what the
+// history is most often asked about.
+func (r *recorder) change(ctx context.Context, taskID int64, userID int64, kind model.TaskEventKind, apply func(ctx context.Context) error) error {
+	return atomically(ctx, r.txTask, r.hub, func(ctx context.Context) error {
+		before, err := r.providerTask.GetTaskByID(ctx, taskID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		if err := apply(ctx); err != nil {
+			return err
+		}
+
+		after, err := r.providerTask.GetTaskByID(ctx, taskID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		changes := diffTask(&before, &after)
+
+		if kind == model.TaskUpdated && slices.ContainsFunc(changes, func(c model.FieldChange) bool { return c.Field == "status" }) {
+			kind = model.TaskStatusChanged
+		}
+
+		return r.record(ctx, taskID, userID, kind, changes, nil)
+	})
+}
+
+// changeChecklist applies a change to the checklist of the task and records
+// the checklist before and after it, one "[x] text" line per item.
+func (r *recorder) changeChecklist(ctx context.Context, taskID int64, userID int64, apply func(ctx context.Context) error) error {
+	return atomically(ctx, r.txTask, r.hub, func(ctx context.Context) error {
+		before, err := r.checklistTask.GetChecklist(ctx, taskID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		if err := apply(ctx); err != nil {
+			return err
+		}
+
+		after, err := r.checklistTask.GetChecklist(ctx, taskID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		change := model.FieldChange{Field: "checklist", Before: formatChecklist(before), After: formatChecklist(after)}
+
+		if change.Before == change.After {
+			return nil
+		}
+
+		return r.record(ctx, taskID, userID, model.TaskChecklist, []model.FieldChange{change}, nil)
+	})
+}
+
+// record writes the history entry; the audience, looked up when not given,
+// gets it once the outermost transaction has committed.
+func (r *recorder) record(ctx context.Context, taskID int64, userID int64, kind model.TaskEventKind, changes []model.FieldChange, audience []int64) error {
+	const op = "tasks.record"
+
+	log := r.log.With(slog.String("op", op), slog.Int64("task_id", taskID), slog.String("kind", string(kind)))
+
+	event := model.TaskEvent{
+		TaskID:    taskID,
+		Actor:     model.TodosUser{ID: userID},
+		Kind:      kind,
+		Changes:   changes,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	id, err := r.eventTask.SaveTaskEvent(ctx, event)
+
+	if err != nil {
+		log.Error("failed to record task event", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	event.ID = id
+
+	if audience == nil {
+		audience, err = r.eventTask.TaskAudience(ctx, taskID)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if pending, ok := ctx.Value(publicationsKey{}).(*[]publication); ok {
+		*pending = append(*pending, publication{event: event, audience: audience})
+		return nil
+	}
+
+	r.hub.Publish(event, audience)
+
+	return nil
+}
+
+// publicationsKey marks a context whose changes may still be rolled back; the
+// recorder collects their events under it instead of publishing them.
+type publicationsKey struct{}
+
+type publication struct {
+	event    model.TaskEvent
+	audience []int64
+}
+
+// trackedFields are the task fields the history keeps, formatted as text.
+var trackedFields = []struct {
+	name  string
+	value func(task *model.Task) string
+}{
+	{"title", func(task *model.Task) string { return task.Title }},
+	{"body", func(task *model.Task) string { return task.Body }},
+	{"status", func(task *model.Task) string { return task.Status.Status }},
+	{"priority", func(task *model.Task) string { return priorityNames[task.Priority] }},
+	{"due_at", func(task *model.Task) string { return formatTime(task.DueAt) }},
+	{"remind_at", func(task *model.Task) string { return formatTime(task.RemindAt) }},
+	{"recurrence", func(task *model.Task) string { return task.Recurrence.Rule }},
+	{"parent_id", func(task *model.Task) string { return formatID(task.ParentID) }},
+	{"project_id", func(task *model.Task) string { return formatID(task.ProjectID) }},
+	{"workspace_id", func(task *model.Task) string { return formatID(task.WorkspaceID) }},
+	{"labels", func(task *model.Task) string {
+		names := make([]string, 0, len(task.Labels))
+		for _, label := range task.Labels {
+			names = append(names, label.Name)
+		}
+		slices.Sort(names)
+		return strings.Join(names, ", ")
+	}},
+	{"assignees", func(task *model.Task) string {
+		logins := make([]string, 0, len(task.Assignees))
+		for _, assignee := range task.Assignees {
+			logins = append(logins, assignee.Login)
+		}
+		slices.Sort(logins)
+		return strings.Join(logins, ", ")
+	}},
+}
+
+var priorityNames = map[model.Priority]string{
+	model.PriorityLow:    "low",
+	model.PriorityMedium: "medium",
+	model.PriorityHigh:   "high",
+	model.PriorityUrgent: "urgent",
+}
+
+// diffTask lists the tracked fields that differ; a nil before stands for a
+// task that did not exist yet.
+func diffTask(before *model.Task, after *model.Task) []model.FieldChange {
+	var changes []model.FieldChange
+
+	for _, field := range trackedFields {
+		var was string
+		if before != nil {
+			was = field.value(before)
+		}
+
+		now := field.value(after)
+
+		if was != now {
+			changes = append(changes, model.FieldChange{Field: field.name, Before: was, After: now})
+		}
+	}
+
+	return changes
+}
+
+func formatChecklist(items []model.ChecklistItem) string {
+	lines := make([]string, 0, len(items))
+
+	for _, item := range items {
+		mark := "[ ] "
+		if item.Done {
+			mark = "[x] "
+		}
+		lines = append(lines, mark+item.Text)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatTime(at *time.Time) string {
+	if at == nil || at.IsZero() {
+		return ""
+	}
+
+	return at.UTC().Format(time.RFC3339)
+}
+
+func formatID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(id, 10)
+}
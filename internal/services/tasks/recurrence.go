@@ -0,0 +1,147 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/lib/rrule"
+	"time"
+)
+
+var (
+	ErrInvalidRecurrence      = errors.New("invalid recurrence")
+	ErrRecurrenceNeedsDueDate = errors.New("recurring task needs a due date")
+)
+
+// newRecurrence checks the rule and the time zone and anchors the series at
+// the due date. The rule is stored in its canonical form, the time zone
+// defaults to UTC.
+func newRecurrence(recurrence model.Recurrence, dueAt *time.Time) (model.Recurrence, error) {
+	rule, err := rrule.Parse(recurrence.Rule)
+
+	if err != nil {
+		return model.Recurrence{}, fmt.Errorf("%w: %s", ErrInvalidRecurrence, err.Error())
+	}
+
+	timezone := recurrence.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return model.Recurrence{}, fmt.Errorf("%w: unknown time zone %q", ErrInvalidRecurrence, timezone)
+	}
+
+	if dueAt == nil || dueAt.IsZero() {
+		return model.Recurrence{}, ErrRecurrenceNeedsDueDate
+	}
+
+	start := dueAt.UTC()
+
+	return model.Recurrence{Rule: rule.String(), Timezone: timezone, Start: &start}, nil
+}
+
+// planRecurrence works out how an update changes the recurrence of the
+// current task: a new rule is anchored at the resulting due date, and moving
+// the due date of a recurring task re-anchors its series there.
+func planRecurrence(current model.Task, update model.UpdateTask) (*model.Recurrence, error) {
+	dueAt := current.DueAt
+	if update.DueAt != nil {
+		dueAt = update.DueAt
+	}
+
+	if update.Recurrence != nil {
+		if update.Recurrence.Rule == "" {
+			return &model.Recurrence{}, nil
+		}
+
+		recurrence, err := newRecurrence(*update.Recurrence, dueAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &recurrence, nil
+	}
+
+	if update.DueAt == nil || current.Recurrence.Rule == "" {
+		return nil, nil
+	}
+
+	recurrence, err := newRecurrence(current.Recurrence, dueAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &recurrence, nil
+}
+
+// scheduleNext creates the next occurrence of a completed recurring task and
+// ends the series on the completed one, so reopening and completing it again
+// cannot fork the series. It returns zero when the series is over.
+func (t *Task) scheduleNext(ctx context.Context, task model.Task, userID int64) (int64, error) {
+	rule, err := rrule.Parse(task.Recurrence.Rule)
+
+	if err != nil {
+		return 0, err
+	}
+
+	loc, err := time.LoadLocation(task.Recurrence.Timezone)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if task.DueAt == nil || task.Recurrence.Start == nil {
+		return 0, ErrRecurrenceNeedsDueDate
+	}
+
+	next, ok := rule.After(task.Recurrence.Start.In(loc), task.DueAt.In(loc))
+
+	var id int64
+
+	if ok {
+		status, err := t.providerStatus.GetStatusByName(ctx, t.workflow.Initial())
+
+		if err != nil {
+			return 0, err
+		}
+
+		dueAt := next.UTC()
+
+		occurrence := model.RequestTask{
+			Title:       task.Title,
+			Body:        task.Body,
+			CreatedAt:   time.Now().UTC(),
+			UserID:      task.User.ID,
+			CreatorID:   userID,
+			StatusID:    status.ID,
+			DueAt:       &dueAt,
+			Priority:    task.Priority,
+			ParentID:    task.ParentID,
+			ProjectID:   task.ProjectID,
+			WorkspaceID: task.WorkspaceID,
+			Recurrence:  task.Recurrence,
+		}
+
+		// The reminder keeps its distance to the due date.
+		if task.RemindAt != nil {
+			remindAt := dueAt.Add(task.RemindAt.Sub(*task.DueAt))
+			occurrence.RemindAt = &remindAt
+		}
+
+		id, err = t.saverTask.SaveTask(ctx, occurrence)
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := t.updaterTask.UpdateTask(ctx, task.ID, userID, model.UpdateTask{Recurrence: &model.Recurrence{}}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
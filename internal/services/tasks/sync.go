@@ -0,0 +1,305 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/lib/pagination"
+	"server/internal/storage"
+	"time"
+)
+
+var (
+	ErrInvalidSyncToken = errors.New("invalid sync token")
+	ErrSyncConflict     = errors.New("task changed on the server after the change")
+)
+
+type SyncTask interface {
+	GetTaskVersion(ctx context.Context, taskID int64, userID int64) (model.TaskVersion, error)
+	ListTaskChanges(ctx context.Context, userID int64, after int64, limit int) (model.TaskChanges, error)
+	GetClientTask(ctx context.Context, userID int64, clientID string) (int64, error)
+	SaveClientTask(ctx context.Context, userID int64, clientID string, taskID int64) error
+}
+
+// SyncTasks applies the changes a client made offline, each on its own, and
+// returns what changed on the server since its sync token.
+func (t *Task) SyncTasks(ctx context.Context, syncToken string, changes []model.TaskSyncChange, limit int) ([]model.TaskSyncResult, model.TaskDelta, error) {
+	const op = "tasks.sync"
+
+	log := t.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, model.TaskDelta{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	token, err := pagination.DecodeSyncToken(syncToken)
+
+	if err != nil {
+		return nil, model.TaskDelta{}, fmt.Errorf("%s: %w", op, ErrInvalidSyncToken)
+	}
+
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	results := make([]model.TaskSyncResult, 0, len(changes))
+
+	for _, change := range changes {
+		result := t.applySyncChange(ctx, change, userID)
+
+		if result.Status == model.TaskSyncRejected {
+			log.Warn("sync change rejected", slog.Int64("task_id", change.TaskID), slog.String("client_id", change.ClientID),
+				slog.String("error", result.Error))
+		}
+
+		results = append(results, result)
+	}
+
+	stored, err := t.syncTask.ListTaskChanges(ctx, userID, token.Revision, limit)
+
+	if err != nil {
+		return nil, model.TaskDelta{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	delta := model.TaskDelta{Deleted: stored.Purged, HasMore: stored.HasMore}
+
+	for _, task := range stored.Tasks {
+		if task.DeletedAt != nil {
+			delta.Deleted = append(delta.Deleted, task.ID)
+			continue
+		}
+		delta.Tasks = append(delta.Tasks, task)
+	}
+
+	delta.SyncToken, err = pagination.EncodeSyncToken(model.SyncToken{Revision: stored.Revision})
+
+	if err != nil {
+		return nil, model.TaskDelta{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return results, delta, nil
+}
+
+// applySyncChange writes one offline change through the regular task paths,
+// so validation, workflow rules and history apply as for any other call.
+func (t *Task) applySyncChange(ctx context.Context, change model.TaskSyncChange, userID int64) model.TaskSyncResult {
+	result := model.TaskSyncResult{ClientID: change.ClientID, TaskID: change.TaskID, Status: model.TaskSyncApplied}
+
+	reject := func(err error) model.TaskSyncResult {
+		result.Status = model.TaskSyncRejected
+		result.Error = err.Error()
+		return result
+	}
+
+	changedAt := change.ChangedAt.UTC()
+
+	// A client clock running ahead must not win every conflict.
+	if now := time.Now().UTC(); changedAt.IsZero() || changedAt.After(now) {
+		changedAt = now
+	}
+
+	switch {
+	case change.Deleted:
+		if change.TaskID == 0 {
+			return reject(ErrTaskNotFound)
+		}
+
+		version, err := t.syncTask.GetTaskVersion(ctx, change.TaskID, userID)
+
+		if err != nil {
+			err = storageError(err)
+
+			if errors.Is(err, ErrTaskNotFound) {
+				return result
+			}
+			return reject(err)
+		}
+
+		// A write on the server after the removal keeps the task.
+		if version.Revision != change.BaseRevision && lastWrite(version).After(changedAt) {
+			return reject(ErrSyncConflict)
+		}
+
+		err = t.RemoveTask(ctx, change.TaskID, false)
+
+		// Deleting a task that is gone already is what the client wanted.
+		if err != nil && !errors.Is(err, ErrTaskNotFound) {
+			return reject(err)
+		}
+
+		return result
+
+	case change.TaskID == 0:
+		update := change.Update
+
+		if update.Title == nil || *update.Title == "" {
+			return reject(errors.New("title is required"))
+		}
+
+		task := model.RequestTask{Title: *update.Title, DueAt: update.DueAt, RemindAt: update.RemindAt}
+
+		if update.Body != nil {
+			task.Body = *update.Body
+		}
+
+		if update.Priority != nil {
+			task.Priority = *update.Priority
+		}
+
+		// A client that did not get the result sends the change again; it
+		// gets the task created the first time.
+		if change.ClientID != "" {
+			id, err := t.syncTask.GetClientTask(ctx, userID, change.ClientID)
+
+			if err == nil {
+				result.TaskID = id
+				return result
+			}
+
+			if !errors.Is(err, storage.ErrClientTaskNotFound) {
+				return reject(err)
+			}
+		}
+
+		var id int64
+
+		err := atomically(ctx, t.txTask, t.hub, func(ctx context.Context) error {
+			var err error
+
+			id, err = t.CreateTask(ctx, task)
+
+			if err != nil || change.ClientID == "" {
+				return err
+			}
+
+			return t.syncTask.SaveClientTask(ctx, userID, change.ClientID, id)
+		})
+
+		if err != nil {
+			return reject(err)
+		}
+
+		result.TaskID = id
+
+		// New tasks start in the initial status; any other one has to be
+		// reachable from there.
+		if update.StatusID != nil {
+			if _, err := t.UpdateTask(ctx, id, model.UpdateTask{StatusID: update.StatusID}); err != nil {
+				result.Status = model.TaskSyncMerged
+				result.Overridden = []string{"status"}
+				result.Error = err.Error()
+			}
+		}
+
+		return result
+	}
+
+	version, err := t.syncTask.GetTaskVersion(ctx, change.TaskID, userID)
+
+	if err != nil {
+		return reject(storageError(err))
+	}
+
+	update := change.Update
+	update.UpdatedAt = changedAt
+
+	// Somebody else changed the task since the client saw it: every field goes
+	// to the most recent write.
+	if version.Revision != change.BaseRevision {
+		result.Overridden = keepNewer(&update, version)
+	}
+
+	if len(result.Overridden) > 0 {
+		result.Status = model.TaskSyncMerged
+	}
+
+	if syncedFields(update) == 0 {
+		return result
+	}
+
+	if _, err := t.UpdateTask(ctx, change.TaskID, update); err != nil {
+		return reject(err)
+	}
+
+	return result
+}
+
+// lastWrite returns when the task was last written.
+func lastWrite(version model.TaskVersion) time.Time {
+	last := version.CreatedAt
+
+	for _, written := range version.FieldTimes {
+		if written.After(last) {
+			last = written
+		}
+	}
+
+	return last
+}
+
+// keepNewer drops from the update every field written on the server after the
+// update was made, returning their names.
+func keepNewer(update *model.UpdateTask, version model.TaskVersion) []string {
+	var overridden []string
+
+	newer := func(field string) bool {
+		written, ok := version.FieldTimes[field]
+		if !ok {
+			written = version.CreatedAt
+		}
+
+		if written.After(update.UpdatedAt) {
+			overridden = append(overridden, field)
+			return true
+		}
+
+		return false
+	}
+
+	if update.Title != nil && newer("title") {
+		update.Title = nil
+	}
+
+	if update.Body != nil && newer("body") {
+		update.Body = nil
+	}
+
+	if update.StatusID != nil && newer("status") {
+		update.StatusID = nil
+	}
+
+	if update.Priority != nil && newer("priority") {
+		update.Priority = nil
+	}
+
+	if update.DueAt != nil && newer("due_at") {
+		update.DueAt = nil
+	}
+
+	if update.RemindAt != nil && newer("remind_at") {
+		update.RemindAt = nil
+	}
+
+	return overridden
+}
+
+func syncedFields(update model.UpdateTask) int {
+	count := 0
+
+	for _, set := range []bool{update.Title != nil, update.Body != nil, update.StatusID != nil,
+		update.Priority != nil, update.DueAt != nil, update.RemindAt != nil} {
+		if set {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,121 @@
+package tasks_test
+
+import (
+	"server/internal/domain/model"
+	"server/internal/services/tasks"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSyncTasksConflict(t *testing.T) {
+	s := newTestService(t)
+
+	_, ctx := s.user(t, "owner")
+
+	// create returns a task and the revision a client would have synced.
+	create := func(t *testing.T, title string) (int64, int64) {
+		t.Helper()
+
+		taskID, err := s.tasks.CreateTask(ctx, model.RequestTask{Title: title})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		task, err := s.tasks.FetchTask(ctx, taskID)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return taskID, task.Revision
+	}
+
+	rename := func(t *testing.T, taskID int64, title string) {
+		t.Helper()
+
+		if _, err := s.tasks.UpdateTask(ctx, taskID, model.UpdateTask{Title: &title}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sync := func(t *testing.T, change model.TaskSyncChange) model.TaskSyncResult {
+		t.Helper()
+
+		results, _, err := s.tasks.SyncTasks(ctx, "", []model.TaskSyncChange{change}, 100)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return results[0]
+	}
+
+	t.Run("newer server field wins the merge", func(t *testing.T) {
+		taskID, base := create(t, "draft")
+
+		changedAt := time.Now()
+
+		rename(t, taskID, "server")
+
+		title, body := "client", "client body"
+
+		result := sync(t, model.TaskSyncChange{TaskID: taskID, BaseRevision: base, ChangedAt: changedAt,
+			Update: model.UpdateTask{Title: &title, Body: &body}})
+
+		if result.Status != model.TaskSyncMerged || !slices.Equal(result.Overridden, []string{"title"}) {
+			t.Fatalf("got status %d overridden %v, want merged over title", result.Status, result.Overridden)
+		}
+
+		task, err := s.tasks.FetchTask(ctx, taskID)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if task.Title != "server" || task.Body != body {
+			t.Fatalf("got title %q body %q, want the server title and the client body", task.Title, task.Body)
+		}
+	})
+
+	t.Run("delete older than a server write is rejected", func(t *testing.T) {
+		taskID, base := create(t, "kept")
+
+		changedAt := time.Now()
+
+		rename(t, taskID, "edited")
+
+		result := sync(t, model.TaskSyncChange{TaskID: taskID, BaseRevision: base, ChangedAt: changedAt, Deleted: true})
+
+		if result.Status != model.TaskSyncRejected || result.Error != tasks.ErrSyncConflict.Error() {
+			t.Fatalf("got status %d error %q, want a sync conflict", result.Status, result.Error)
+		}
+
+		if _, err := s.tasks.FetchTask(ctx, taskID); err != nil {
+			t.Fatalf("task removed despite the conflict: %v", err)
+		}
+	})
+
+	t.Run("delete newer than a server write is applied", func(t *testing.T) {
+		taskID, base := create(t, "removed")
+
+		rename(t, taskID, "edited")
+
+		result := sync(t, model.TaskSyncChange{TaskID: taskID, BaseRevision: base, ChangedAt: time.Now(), Deleted: true})
+
+		if result.Status != model.TaskSyncApplied {
+			t.Fatalf("got status %d error %q, want applied", result.Status, result.Error)
+		}
+
+		trash, err := s.tasks.FetchTrash(ctx, 100)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.ContainsFunc(trash, func(task model.Task) bool { return task.ID == taskID }) {
+			t.Fatalf("task %d not in the trash", taskID)
+		}
+	})
+}
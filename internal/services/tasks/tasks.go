@@ -2,30 +2,119 @@ package tasks
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"server/internal/domain/model"
+	"server/internal/lib/pagination"
+	"server/internal/storage"
+	"slices"
 	"time"
 )
 
+var (
+	ErrTaskNotFound     = errors.New("task not found")
+	ErrTaskAccessDenied = errors.New("access to the task is denied")
+	ErrTaskNotInTrash   = errors.New("task is not in the trash")
+	ErrStatusNotFound   = errors.New("status not found")
+	ErrParentNotFound   = errors.New("parent task not found")
+
+	ErrProjectNotFound     = errors.New("project not found")
+	ErrProjectAccessDenied = errors.New("project belongs to another user")
+	ErrStatusNotInProject  = errors.New("status is not available in the project")
+
+	ErrWorkspaceNotFound     = errors.New("workspace not found")
+	ErrWorkspaceAccessDenied = errors.New("workspace role does not allow this operation")
+	ErrAssigneeAccessDenied  = errors.New("assignee has no access to the task")
+
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+
+	ErrTransitionNotAllowed = errors.New("status transition is not allowed")
+	ErrInvalidPageToken     = errors.New("invalid page token")
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
 type Task struct {
-	log          *slog.Logger
-	saverTask    SaverTask
-	removerTask  RemoverTask
-	providerTask ProviderTask
+	log             *slog.Logger
+	saverTask       SaverTask
+	removerTask     RemoverTask
+	trashTask       TrashTask
+	providerTask    ProviderTask
+	updaterTask     UpdaterTask
+	searcherTask    SearcherTask
+	checklistTask   ChecklistTask
+	eventTask       EventTask
+	hub             Hub
+	syncTask        SyncTask
+	txTask          TxTask
+	providerStatus  ProviderStatus
+	providerProject ProviderProject
+	memberWorkspace MemberWorkspace
+	blobs           BlobRemover
+	workflow        Workflow
+	watchPoll       time.Duration
 }
 
 func New(
 	log *slog.Logger,
 	saverTask SaverTask,
 	removerTask RemoverTask,
+	trashTask TrashTask,
 	providerTask ProviderTask,
+	updaterTask UpdaterTask,
+	searcherTask SearcherTask,
+	checklistTask ChecklistTask,
+	eventTask EventTask,
+	hub Hub,
+	syncTask SyncTask,
+	txTask TxTask,
+	providerStatus ProviderStatus,
+	providerProject ProviderProject,
+	memberWorkspace MemberWorkspace,
+	blobs BlobRemover,
+	workflow Workflow,
+	watchPollInterval time.Duration,
 ) *Task {
+	// Every change goes through the recorder, which keeps the task history.
+	recorder := &recorder{
+		log:           log,
+		eventTask:     eventTask,
+		hub:           hub,
+		txTask:        txTask,
+		providerTask:  providerTask,
+		saverTask:     saverTask,
+		removerTask:   removerTask,
+		trashTask:     trashTask,
+		updaterTask:   updaterTask,
+		checklistTask: checklistTask,
+	}
+
 	return &Task{
-		log:          log,
-		saverTask:    saverTask,
-		removerTask:  removerTask,
-		providerTask: providerTask,
+		log:             log,
+		saverTask:       recorder,
+		removerTask:     recorder,
+		trashTask:       recorder,
+		providerTask:    providerTask,
+		updaterTask:     recorder,
+		searcherTask:    searcherTask,
+		checklistTask:   recorder,
+		eventTask:       eventTask,
+		hub:             hub,
+		syncTask:        syncTask,
+		txTask:          txTask,
+		providerStatus:  providerStatus,
+		providerProject: providerProject,
+		memberWorkspace: memberWorkspace,
+		blobs:           blobs,
+		workflow:        workflow,
+		watchPoll:       watchPollInterval,
 	}
 }
 
@@ -34,15 +123,62 @@ type SaverTask interface {
 }
 
 type RemoverTask interface {
-	Remove(ctx context.Context, taskID int64) error
+	Remove(ctx context.Context, taskID int64, userID int64, cascade bool, deletedAt time.Time) error
+}
+
+type TrashTask interface {
+	ListTrash(ctx context.Context, userID int64, limit int) ([]model.Task, error)
+	RestoreTask(ctx context.Context, taskID int64, userID int64) error
+	PurgeTask(ctx context.Context, taskID int64, userID int64) ([]string, error)
+	ExpiredTrash(ctx context.Context, before time.Time, limit int) ([]int64, error)
+	PurgeExpiredTask(ctx context.Context, taskID int64) ([]string, error)
 }
 
 type ProviderTask interface {
-	GetTaskByID(ctx context.Context, taskID int64) (model.Task, error)
-	GetAllUserTasks(ctx context.Context, userID int64) ([]model.Task, error)
+	GetTaskByID(ctx context.Context, taskID int64, userID int64) (model.Task, error)
+	GetSubtasks(ctx context.Context, taskID int64, userID int64) ([]model.Task, error)
+	ListUserTasks(ctx context.Context, filter model.TaskFilter) ([]model.Task, error)
+	ListOverdueTasks(ctx context.Context, userID int64, now time.Time, closedStatuses []string, limit int) ([]model.Task, error)
+}
+
+type UpdaterTask interface {
+	UpdateTask(ctx context.Context, taskID int64, userID int64, task model.UpdateTask) error
+	MoveTask(ctx context.Context, taskID int64, userID int64, projectID int64) error
+	AssignTask(ctx context.Context, taskID int64, assigneeID int64, userID int64) error
+	UnassignTask(ctx context.Context, taskID int64, assigneeID int64, userID int64) error
+}
+
+type SearcherTask interface {
+	SearchTasks(ctx context.Context, userID int64, query string, limit int) ([]model.TaskSearchResult, error)
+}
+
+type ChecklistTask interface {
+	SaveChecklistItem(ctx context.Context, taskID int64, userID int64, text string) (model.ChecklistItem, error)
+	GetChecklist(ctx context.Context, taskID int64, userID int64) ([]model.ChecklistItem, error)
+	ToggleChecklistItem(ctx context.Context, taskID int64, itemID int64, userID int64, done bool) (model.ChecklistItem, error)
+	ReorderChecklistItems(ctx context.Context, taskID int64, userID int64, itemIDs []int64) error
+}
+
+type ProviderProject interface {
+	GetProject(ctx context.Context, projectID int64, userID int64) (model.Project, error)
 }
 
-func (t *Task) CreateTask(ctx context.Context, title string, body string) (int64, error) {
+type MemberWorkspace interface {
+	MemberRole(ctx context.Context, workspaceID int64, userID int64) (model.Role, error)
+}
+
+// BlobRemover drops the attachment files of purged tasks.
+type BlobRemover interface {
+	Delete(ctx context.Context, key string) error
+}
+
+type ProviderStatus interface {
+	GetStatuses(ctx context.Context, userID int64) ([]model.Status, error)
+	GetStatusByID(ctx context.Context, statusID int64, userID int64) (model.Status, error)
+	GetStatusByName(ctx context.Context, name string) (model.Status, error)
+}
+
+func (t *Task) CreateTask(ctx context.Context, task model.RequestTask) (int64, error) {
 	const op = "task.create"
 
 	userID, ok := ctx.Value("user_id").(int64)
@@ -51,17 +187,77 @@ func (t *Task) CreateTask(ctx context.Context, title string, body string) (int64
 		return 0, fmt.Errorf("Not found user_id in context")
 	}
 
-	var task model.RequestTask
+	task.CreatedAt = time.Now().UTC()
 
-	task.Title = title
+	task.UserID = userID
 
-	task.Body = body
+	task.CreatorID = userID
 
-	task.CreatedAt = time.Now().UTC()
+	if task.ParentID != 0 {
+		parent, err := t.providerTask.GetTaskByID(ctx, task.ParentID, userID)
 
-	task.UserID = userID
+		if err != nil {
+			if errors.Is(err, storage.ErrTaskNotFound) || errors.Is(err, storage.ErrTaskAccessDenied) {
+				return 0, fmt.Errorf("%s: %w", op, ErrParentNotFound)
+			}
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Subtasks live in the parent's project unless told otherwise,
+		// and always in the parent's workspace.
+		if task.ProjectID == 0 {
+			task.ProjectID = parent.ProjectID
+		}
+
+		task.WorkspaceID = parent.WorkspaceID
+	}
+
+	if task.WorkspaceID != 0 {
+		role, err := t.memberWorkspace.MemberRole(ctx, task.WorkspaceID, userID)
+
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, storageError(err))
+		}
+
+		if !role.CanWrite() {
+			return 0, fmt.Errorf("%s: %w", op, ErrWorkspaceAccessDenied)
+		}
+	}
+
+	if task.ProjectID != 0 {
+		if _, err := t.providerProject.GetProject(ctx, task.ProjectID, userID); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, storageError(err))
+		}
+	}
 
-	task.StatusID = 1
+	if task.Recurrence.Rule != "" {
+		recurrence, err := newRecurrence(task.Recurrence, task.DueAt)
+
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		task.Recurrence = recurrence
+	}
+
+	// Imported tasks keep their status; all others start in the initial one.
+	if task.StatusID != 0 {
+		if _, err := t.providerStatus.GetStatusByID(ctx, task.StatusID, userID); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, storageError(err))
+		}
+
+		if err := t.checkProjectStatus(ctx, task.ProjectID, task.StatusID, userID); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	} else {
+		status, err := t.providerStatus.GetStatusByName(ctx, t.workflow.Initial())
+
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		task.StatusID = status.ID
+	}
 
 	id, err := t.saverTask.SaveTask(ctx, task)
 
@@ -74,37 +270,260 @@ func (t *Task) CreateTask(ctx context.Context, title string, body string) (int64
 func (t *Task) FetchTask(ctx context.Context, taskID int64) (model.Task, error) {
 	const op = "task.fetch"
 
-	task, err := t.providerTask.GetTaskByID(ctx, taskID)
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Task{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	task, err := t.providerTask.GetTaskByID(ctx, taskID, userID)
 
 	if err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if err := t.loadDetails(ctx, &task, userID); err != nil {
 		return model.Task{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return task, nil
 }
 
-func (t *Task) RemoveTask(ctx context.Context, taskID int64) error {
+// RemoveTask moves the task into the trash; with cascade its subtasks go too,
+// otherwise they move up to the task's parent.
+func (t *Task) RemoveTask(ctx context.Context, taskID int64, cascade bool) error {
 	const op = "task.remove"
 
-	err := t.removerTask.Remove(ctx, taskID)
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	err := t.removerTask.Remove(ctx, taskID, userID, cascade, time.Now().UTC())
 
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return fmt.Errorf("%s: %w", op, storageError(err))
 	}
 
 	return nil
 }
 
-func (t *Task) FetchTasks(ctx context.Context) ([]model.Task, error) {
+func (t *Task) FetchTasks(ctx context.Context, filter model.TaskFilter, pageToken string) ([]model.Task, string, error) {
 	const op = "tasks.fetch"
 
 	userID, ok := ctx.Value("user_id").(int64)
 
+	if !ok {
+		return nil, "", fmt.Errorf("Not found user_id in context")
+	}
+
+	filter.UserID = userID
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultPageSize
+	}
+
+	if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+
+	fingerprint, err := filterFingerprint(filter)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if pageToken != "" {
+		cursor, err := pagination.DecodeTaskCursor(pageToken)
+
+		if err != nil || cursor.Sort != filter.Sort || cursor.Filter != fingerprint {
+			return nil, "", fmt.Errorf("%s: %w", op, ErrInvalidPageToken)
+		}
+
+		filter.After = &cursor
+	}
+
+	pageSize := filter.Limit
+
+	// One extra row tells whether another page exists.
+	filter.Limit++
+
+	tasks, err := t.providerTask.ListUserTasks(ctx, filter)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(tasks) <= pageSize {
+		return tasks, "", nil
+	}
+
+	tasks = tasks[:pageSize]
+
+	last := tasks[pageSize-1]
+
+	nextPageToken, err := pagination.EncodeTaskCursor(model.TaskCursor{
+		Sort:      filter.Sort,
+		Filter:    fingerprint,
+		CreatedAt: last.CreatedAt,
+		Title:     last.Title,
+		ID:        last.ID,
+	})
+
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nextPageToken, nil
+}
+
+// filterFingerprint identifies what the filter selects and in which order,
+// whatever the order of the ids given.
+func filterFingerprint(filter model.TaskFilter) (string, error) {
+	sorted := func(ids []int64) []int64 {
+		ids = slices.Clone(ids)
+		slices.Sort(ids)
+		return ids
+	}
+
+	priorities := slices.Clone(filter.Priorities)
+	slices.Sort(priorities)
+
+	raw, err := json.Marshal([]interface{}{
+		filter.UserID, filter.ProjectID, filter.WorkspaceID, filter.AssignedToMe, sorted(filter.StatusIDs), sorted(filter.LabelIDs),
+		priorities, filter.CreatedAfter.UTC(), filter.CreatedBefore.UTC(), filter.Sort,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:12]), nil
+}
+
+func (t *Task) UpdateTask(ctx context.Context, taskID int64, task model.UpdateTask) (model.Task, error) {
+	const op = "task.update"
+
+	log := t.log.With(slog.String("op", op), slog.Int64("task_id", taskID))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Task{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	if task.UpdatedAt.IsZero() {
+		task.UpdatedAt = time.Now().UTC()
+	}
+
+	var updated model.Task
+
+	// The checks read the task in the transaction of the update, so a change
+	// made in between cannot slip past them. Completing a recurring task and
+	// scheduling its next occurrence go together as well, so a failure cannot
+	// leave the series without an open task.
+	err := atomically(ctx, t.txTask, t.hub, func(ctx context.Context) error {
+		var current model.Task
+
+		if task.StatusID != nil || task.DueAt != nil || task.Recurrence != nil {
+			var err error
+
+			current, err = t.providerTask.GetTaskByID(ctx, taskID, userID)
+
+			if err != nil {
+				return storageError(err)
+			}
+
+			recurrence, err := planRecurrence(current, task)
+
+			if err != nil {
+				return err
+			}
+
+			task.Recurrence = recurrence
+		}
+
+		if task.StatusID != nil {
+			if err := t.checkTransition(ctx, current.Status, *task.StatusID, userID); err != nil {
+				log.Warn("status change rejected", slog.String("error", err.Error()))
+				return err
+			}
+
+			if err := t.checkProjectStatus(ctx, current.ProjectID, *task.StatusID, userID); err != nil {
+				log.Warn("status change rejected", slog.String("error", err.Error()))
+				return err
+			}
+		}
+
+		if err := t.updaterTask.UpdateTask(ctx, taskID, userID, task); err != nil {
+			log.Warn("failed to update task", slog.String("error", err.Error()))
+			return storageError(err)
+		}
+
+		var err error
+
+		updated, err = t.providerTask.GetTaskByID(ctx, taskID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		completed := t.workflow.Completed()
+
+		if updated.Recurrence.Rule == "" || updated.Status.Status != completed || current.Status.Status == completed {
+			return nil
+		}
+
+		nextID, err := t.scheduleNext(ctx, updated, userID)
+
+		if err != nil {
+			log.Error("failed to schedule the next occurrence", slog.String("error", err.Error()))
+			return err
+		}
+
+		if nextID != 0 {
+			log.Info("next occurrence scheduled", slog.Int64("next_task_id", nextID))
+		} else {
+			log.Info("recurrence series ended")
+		}
+
+		updated.Recurrence = model.Recurrence{}
+
+		return nil
+	})
+
+	if err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.loadDetails(ctx, &updated, userID); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+func (t *Task) FetchOverdueTasks(ctx context.Context, limit int) ([]model.Task, error) {
+	const op = "tasks.fetch_overdue"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
 	if !ok {
 		return nil, fmt.Errorf("Not found user_id in context")
 	}
 
-	tasks, err := t.providerTask.GetAllUserTasks(ctx, userID)
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	tasks, err := t.providerTask.ListOverdueTasks(ctx, userID, time.Now().UTC(), t.workflow.Closed(), limit)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -112,3 +531,281 @@ func (t *Task) FetchTasks(ctx context.Context) ([]model.Task, error) {
 
 	return tasks, nil
 }
+
+func (t *Task) SearchTasks(ctx context.Context, query string, limit int) ([]model.TaskSearchResult, error) {
+	const op = "tasks.search"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	results, err := t.searcherTask.SearchTasks(ctx, userID, query, limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return results, nil
+}
+
+func (t *Task) ChangeTaskStatus(ctx context.Context, taskID int64, statusID int64) (model.Task, error) {
+	return t.UpdateTask(ctx, taskID, model.UpdateTask{StatusID: &statusID})
+}
+
+func (t *Task) FetchStatuses(ctx context.Context) ([]model.Status, error) {
+	const op = "task.fetch_statuses"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	statuses, err := t.providerStatus.GetStatuses(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statuses, nil
+}
+
+// MoveTask puts the task into the project; a zero projectID takes it out of
+// any project. The task's current status must be available in the project.
+func (t *Task) MoveTask(ctx context.Context, taskID int64, projectID int64) (model.Task, error) {
+	const op = "task.move"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Task{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	current, err := t.providerTask.GetTaskByID(ctx, taskID, userID)
+
+	if err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if err := t.checkProjectStatus(ctx, projectID, current.Status.ID, userID); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.updaterTask.MoveTask(ctx, taskID, userID, projectID); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return t.FetchTask(ctx, taskID)
+}
+
+func (t *Task) AssignTask(ctx context.Context, taskID int64, assigneeID int64) (model.Task, error) {
+	const op = "task.assign"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Task{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := t.updaterTask.AssignTask(ctx, taskID, assigneeID, userID); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return t.FetchTask(ctx, taskID)
+}
+
+func (t *Task) UnassignTask(ctx context.Context, taskID int64, assigneeID int64) (model.Task, error) {
+	const op = "task.unassign"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Task{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := t.updaterTask.UnassignTask(ctx, taskID, assigneeID, userID); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return t.FetchTask(ctx, taskID)
+}
+
+func (t *Task) AddChecklistItem(ctx context.Context, taskID int64, text string) (model.ChecklistItem, error) {
+	const op = "task.add_checklist_item"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.ChecklistItem{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	item, err := t.checklistTask.SaveChecklistItem(ctx, taskID, userID, text)
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return item, nil
+}
+
+func (t *Task) ToggleChecklistItem(ctx context.Context, taskID int64, itemID int64, done bool) (model.ChecklistItem, error) {
+	const op = "task.toggle_checklist_item"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.ChecklistItem{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	item, err := t.checklistTask.ToggleChecklistItem(ctx, taskID, itemID, userID, done)
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return item, nil
+}
+
+func (t *Task) ReorderChecklistItems(ctx context.Context, taskID int64, itemIDs []int64) ([]model.ChecklistItem, error) {
+	const op = "task.reorder_checklist_items"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := t.checklistTask.ReorderChecklistItems(ctx, taskID, userID, itemIDs); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	items, err := t.checklistTask.GetChecklist(ctx, taskID, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+// loadDetails fills the subtasks, the checklist and the completion progress
+// of a single task.
+func (t *Task) loadDetails(ctx context.Context, task *model.Task, userID int64) error {
+	subtasks, err := t.providerTask.GetSubtasks(ctx, task.ID, userID)
+
+	if err != nil {
+		return err
+	}
+
+	checklist, err := t.checklistTask.GetChecklist(ctx, task.ID, userID)
+
+	if err != nil {
+		return err
+	}
+
+	task.Subtasks = subtasks
+
+	task.Checklist = checklist
+
+	total := len(subtasks) + len(checklist)
+
+	if total == 0 {
+		return nil
+	}
+
+	closed := make(map[string]bool)
+	for _, status := range t.workflow.Closed() {
+		closed[status] = true
+	}
+
+	completed := 0
+
+	for _, item := range checklist {
+		if item.Done {
+			completed++
+		}
+	}
+
+	for _, subtask := range subtasks {
+		if closed[subtask.Status.Status] {
+			completed++
+		}
+	}
+
+	task.Progress = completed * 100 / total
+
+	return nil
+}
+
+func (t *Task) checkTransition(ctx context.Context, from model.Status, statusID int64, userID int64) error {
+	to, err := t.providerStatus.GetStatusByID(ctx, statusID, userID)
+
+	if err != nil {
+		return storageError(err)
+	}
+
+	if !t.workflow.CanTransition(from.Status, to.Status) {
+		return fmt.Errorf("%w: %q -> %q", ErrTransitionNotAllowed, from.Status, to.Status)
+	}
+
+	return nil
+}
+
+// checkProjectStatus makes sure the status belongs to the project's status set.
+// Tasks outside of any project may use every status.
+func (t *Task) checkProjectStatus(ctx context.Context, projectID int64, statusID int64, userID int64) error {
+	if projectID == 0 {
+		return nil
+	}
+
+	project, err := t.providerProject.GetProject(ctx, projectID, userID)
+
+	if err != nil {
+		return storageError(err)
+	}
+
+	for _, status := range project.Statuses {
+		if status.ID == statusID {
+			return nil
+		}
+	}
+
+	return ErrStatusNotInProject
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrTaskNotFound):
+		return ErrTaskNotFound
+	case errors.Is(err, storage.ErrTaskAccessDenied):
+		return ErrTaskAccessDenied
+	case errors.Is(err, storage.ErrTaskNotInTrash):
+		return ErrTaskNotInTrash
+	case errors.Is(err, storage.ErrStatusNotFound):
+		return ErrStatusNotFound
+	case errors.Is(err, storage.ErrChecklistItemNotFound):
+		return ErrChecklistItemNotFound
+	case errors.Is(err, storage.ErrProjectNotFound):
+		return ErrProjectNotFound
+	case errors.Is(err, storage.ErrProjectAccessDenied):
+		return ErrProjectAccessDenied
+	case errors.Is(err, storage.ErrWorkspaceNotFound):
+		return ErrWorkspaceNotFound
+	case errors.Is(err, storage.ErrWorkspaceAccessDenied):
+		return ErrWorkspaceAccessDenied
+	case errors.Is(err, storage.ErrAssigneeAccessDenied):
+		return ErrAssigneeAccessDenied
+	}
+
+	return err
+}
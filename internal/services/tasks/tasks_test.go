@@ -0,0 +1,134 @@
+package tasks_test
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"server/internal/lib/hub"
+	"server/internal/services/tasks"
+	"server/internal/storage/sqlite"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const migrationsPath = "../../../migrations"
+
+type testService struct {
+	tasks  *tasks.Task
+	events *sqlite.TaskEventStorage
+	users  *sqlite.UserStorage
+}
+
+type nopBlobs struct{}
+
+func (nopBlobs) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+// newTestService returns the service over a fresh migrated database, wired
+// the way the app wires it.
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(migrationsPath, "*.up.sql"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version := func(path string) int {
+		n, _ := strconv.Atoi(strings.SplitN(filepath.Base(path), "_", 2)[0])
+		return n
+	}
+
+	sort.Slice(files, func(i, j int) bool { return version(files[i]) < version(files[j]) })
+
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	db, err := sql.Open("sqlite3", path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		migration, err := os.ReadFile(file)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := db.Exec(string(migration)); err != nil {
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				t.Fatalf("%s: %v; run the tests with -tags sqlite_fts5", filepath.Base(file), err)
+			}
+			t.Fatalf("%s: %v", filepath.Base(file), err)
+		}
+	}
+
+	db.Close()
+
+	db, err = sqlite.Open(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	ts := sqlite.NewTaskStorage(db)
+	es := sqlite.NewTaskEventStorage(db)
+
+	workflow := tasks.NewWorkflow("pending", map[string][]string{
+		"pending":     {"in progress", "complete", "archived"},
+		"in progress": {"pending", "complete", "archived"},
+		"complete":    {"pending", "in progress", "archived"},
+		"archived":    {},
+	}, []string{"complete", "archived"}, "complete")
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	service := tasks.New(log, ts, ts, ts, ts, ts, ts, ts, es, hub.New(), ts, ts, sqlite.NewStatusStorage(db),
+		sqlite.NewProjectStorage(db), sqlite.NewWorkspaceStorage(db), nopBlobs{}, workflow, 0)
+
+	return &testService{tasks: service, events: es, users: sqlite.NewUserStorage(db)}
+}
+
+// user registers a user and returns the context its calls are made in.
+func (s *testService) user(t *testing.T, login string) (int64, context.Context) {
+	t.Helper()
+
+	userID, err := s.users.SaveUser(context.Background(), login, []byte("hash"), login)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return userID, context.WithValue(context.Background(), "user_id", userID)
+}
+
+// status returns the id of the status with the given name.
+func (s *testService) status(t *testing.T, ctx context.Context, name string) int64 {
+	t.Helper()
+
+	statuses, err := s.tasks.FetchStatuses(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, status := range statuses {
+		if status.Status == name {
+			return status.ID
+		}
+	}
+
+	t.Fatalf("no status %q", name)
+
+	return 0
+}
@@ -0,0 +1,263 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/lib/taskfile"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format")
+	ErrImportTooLarge    = errors.New("import has too many rows")
+	ErrInvalidImportFile = errors.New("invalid import file")
+)
+
+// errImportDryRun rolls a dry run back once every row has been tried.
+var errImportDryRun = errors.New("import dry run")
+
+const (
+	maxImportRows   = 10000
+	maxImportErrors = 1000
+)
+
+// ExportTasks writes the tasks the filter selects, oldest first, so that a
+// parent always comes before its subtasks. Trashed tasks are left out.
+func (t *Task) ExportTasks(ctx context.Context, filter model.TaskFilter, format model.TaskFileFormat, w io.Writer) error {
+	const op = "tasks.export"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	writer, err := taskfile.NewWriter(w, format)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, ErrUnsupportedFormat)
+	}
+
+	filter.UserID = userID
+	filter.Sort = model.TaskSortCreatedAtAsc
+	filter.Limit = maxPageSize
+	filter.After = nil
+
+	for {
+		tasks, err := t.providerTask.ListUserTasks(ctx, filter)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, task := range tasks {
+			if err := writer.Write(taskRecord(task)); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if len(tasks) < filter.Limit {
+			break
+		}
+
+		last := tasks[len(tasks)-1]
+		filter.After = &model.TaskCursor{Sort: filter.Sort, CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ImportTasks creates a task for every row of the file, reporting and skipping
+// the rows that fail; a dry run keeps none of them.
+func (t *Task) ImportTasks(ctx context.Context, options model.TaskImportOptions, r io.Reader) (model.TaskImportReport, error) {
+	const op = "tasks.import"
+
+	log := t.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.TaskImportReport{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	reader, err := taskfile.NewReader(r, options.Format)
+
+	if err != nil {
+		return model.TaskImportReport{}, fmt.Errorf("%s: %w", op, ErrUnsupportedFormat)
+	}
+
+	report := model.TaskImportReport{DryRun: options.DryRun}
+
+	fail := func(row int, err error) {
+		report.Failed++
+		if len(report.Errors) < maxImportErrors {
+			report.Errors = append(report.Errors, model.TaskImportError{Row: row, Err: err})
+		}
+	}
+
+	var records []model.TaskRecord
+
+	for {
+		record, err := reader.Read()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr *taskfile.RowError
+
+		if errors.As(err, &rowErr) {
+			report.Rows++
+			fail(rowErr.Row, rowErr)
+			continue
+		}
+
+		if errors.Is(err, taskfile.ErrMissingColumn) {
+			return model.TaskImportReport{}, fmt.Errorf("%s: %w: %w", op, ErrInvalidImportFile, err)
+		}
+
+		if err != nil {
+			return model.TaskImportReport{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		report.Rows++
+
+		if report.Rows > maxImportRows {
+			return model.TaskImportReport{}, fmt.Errorf("%s: %w", op, ErrImportTooLarge)
+		}
+
+		records = append(records, record)
+	}
+
+	statuses, err := t.providerStatus.GetStatuses(ctx, userID)
+
+	if err != nil {
+		return model.TaskImportReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	statusIDs := make(map[string]int64, len(statuses))
+	for _, status := range statuses {
+		statusIDs[strings.ToLower(status.Status)] = status.ID
+	}
+
+	// Watchers hear of the new tasks once they are committed.
+	var published []publication
+
+	err = t.txTask.Atomically(ctx, func(ctx context.Context) error {
+		// created maps the ids of the file to the ids of the created tasks.
+		created := make(map[int64]int64)
+
+		for _, record := range records {
+			var pending []publication
+			var id int64
+
+			rowCtx := context.WithValue(ctx, publicationsKey{}, &pending)
+
+			err := t.txTask.Atomically(rowCtx, func(ctx context.Context) error {
+				var err error
+				id, err = t.importRecord(ctx, record, options, statusIDs, created)
+				return err
+			})
+
+			if err != nil {
+				fail(record.Row, err)
+				continue
+			}
+
+			if record.ID != 0 {
+				created[record.ID] = id
+			}
+
+			report.Imported++
+			published = append(published, pending...)
+		}
+
+		if options.DryRun {
+			return errImportDryRun
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errImportDryRun) {
+		return model.TaskImportReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Broken rows were reported while reading, the failed ones after.
+	slices.SortStableFunc(report.Errors, func(a, b model.TaskImportError) int { return a.Row - b.Row })
+
+	if !options.DryRun {
+		for _, p := range published {
+			t.hub.Publish(p.event, p.audience)
+		}
+	}
+
+	log.Info("tasks imported", slog.Int("rows", report.Rows), slog.Int("imported", report.Imported),
+		slog.Int("failed", report.Failed), slog.Bool("dry_run", report.DryRun))
+
+	return report, nil
+}
+
+func (t *Task) importRecord(ctx context.Context, record model.TaskRecord, options model.TaskImportOptions, statusIDs map[string]int64, created map[int64]int64) (int64, error) {
+	task := model.RequestTask{
+		Title:       record.Title,
+		Body:        record.Body,
+		DueAt:       record.DueAt,
+		RemindAt:    record.RemindAt,
+		Priority:    record.Priority,
+		ProjectID:   options.ProjectID,
+		WorkspaceID: options.WorkspaceID,
+		Recurrence:  model.Recurrence{Rule: record.Recurrence, Timezone: record.RecurrenceTimezone},
+	}
+
+	if record.Status != "" {
+		statusID, ok := statusIDs[strings.ToLower(record.Status)]
+
+		if !ok {
+			return 0, &taskfile.RowError{Row: record.Row, Err: fmt.Errorf("unknown status %q", record.Status)}
+		}
+
+		task.StatusID = statusID
+	}
+
+	if record.ParentID != 0 {
+		parentID, ok := created[record.ParentID]
+
+		if !ok {
+			return 0, &taskfile.RowError{Row: record.Row, Err: fmt.Errorf("parent %d is not among the imported rows above", record.ParentID)}
+		}
+
+		task.ParentID = parentID
+	}
+
+	return t.CreateTask(ctx, task)
+}
+
+func taskRecord(task model.Task) model.TaskRecord {
+	record := model.TaskRecord{
+		ID:                 task.ID,
+		ParentID:           task.ParentID,
+		Title:              task.Title,
+		Body:               task.Body,
+		Status:             task.Status.Status,
+		Priority:           task.Priority,
+		DueAt:              task.DueAt,
+		RemindAt:           task.RemindAt,
+		Recurrence:         task.Recurrence.Rule,
+		RecurrenceTimezone: task.Recurrence.Timezone,
+	}
+
+	createdAt := task.CreatedAt
+	record.CreatedAt = &createdAt
+
+	return record
+}
@@ -0,0 +1,128 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"time"
+)
+
+func (t *Task) FetchTrash(ctx context.Context, limit int) ([]model.Task, error) {
+	const op = "tasks.fetch_trash"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	tasks, err := t.trashTask.ListTrash(ctx, userID, limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+// RestoreTask takes the task out of the trash along with the subtasks that
+// were removed together with it.
+func (t *Task) RestoreTask(ctx context.Context, taskID int64) (model.Task, error) {
+	const op = "task.restore"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Task{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := t.trashTask.RestoreTask(ctx, taskID, userID); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	task, err := t.providerTask.GetTaskByID(ctx, taskID, userID)
+
+	if err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if err := t.loadDetails(ctx, &task, userID); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return task, nil
+}
+
+// PurgeTask deletes a trashed task for good, together with its subtree and
+// the files attached to them.
+func (t *Task) PurgeTask(ctx context.Context, taskID int64) error {
+	const op = "task.purge"
+
+	log := t.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	blobKeys, err := t.trashTask.PurgeTask(ctx, taskID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	// The metadata is gone already, a file left behind is only logged.
+	for _, key := range blobKeys {
+		if err := t.blobs.Delete(context.WithoutCancel(ctx), key); err != nil {
+			log.Warn("failed to delete blob", slog.String("key", key), slog.String("error", err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// PurgeTrash purges up to limit tasks trashed before the given time and
+// returns their count and the blob keys left for the caller to remove.
+func (t *Task) PurgeTrash(ctx context.Context, before time.Time, limit int) (int, []string, error) {
+	const op = "task.purge_trash"
+
+	var purged int
+	var blobKeys []string
+
+	err := atomically(ctx, t.txTask, t.hub, func(ctx context.Context) error {
+		taskIDs, err := t.trashTask.ExpiredTrash(ctx, before, limit)
+
+		if err != nil {
+			return err
+		}
+
+		for _, taskID := range taskIDs {
+			keys, err := t.trashTask.PurgeExpiredTask(ctx, taskID)
+
+			if err != nil {
+				return err
+			}
+			blobKeys = append(blobKeys, keys...)
+		}
+
+		purged = len(taskIDs)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purged, blobKeys, nil
+}
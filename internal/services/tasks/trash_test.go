@@ -0,0 +1,86 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"server/internal/domain/model"
+	"server/internal/services/tasks"
+	"testing"
+	"time"
+)
+
+func TestPurgeTrash(t *testing.T) {
+	s := newTestService(t)
+
+	userID, ctx := s.user(t, "owner")
+
+	old, err := s.tasks.CreateTask(ctx, model.RequestTask{Title: "old"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recent, err := s.tasks.CreateTask(ctx, model.RequestTask{Title: "recent"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.tasks.RemoveTask(ctx, old, false); err != nil {
+		t.Fatal(err)
+	}
+
+	cutoff := time.Now()
+
+	if err := s.tasks.RemoveTask(ctx, recent, false); err != nil {
+		t.Fatal(err)
+	}
+
+	purged, _, err := s.tasks.PurgeTrash(context.Background(), cutoff, 100)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if purged != 1 {
+		t.Fatalf("purged %d tasks, want 1", purged)
+	}
+
+	t.Run("task trashed before the cutoff is gone", func(t *testing.T) {
+		if _, err := s.tasks.RestoreTask(ctx, old); !errors.Is(err, tasks.ErrTaskNotFound) {
+			t.Fatalf("restore purged task: %v, want ErrTaskNotFound", err)
+		}
+	})
+
+	t.Run("task trashed after the cutoff is kept", func(t *testing.T) {
+		trash, err := s.tasks.FetchTrash(ctx, 100)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(trash) != 1 || trash[0].ID != recent {
+			t.Fatalf("trash holds %v, want only task %d", trash, recent)
+		}
+	})
+
+	t.Run("purge is recorded for the owner", func(t *testing.T) {
+		events, err := s.events.ListUserTaskEvents(ctx, userID, 0, 100)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var found bool
+
+		for _, event := range events {
+			if event.TaskID == old && event.Kind == model.TaskPurged {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Fatalf("no purge event of task %d in %v", old, events)
+		}
+	})
+}
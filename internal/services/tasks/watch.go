@@ -0,0 +1,137 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+const replayBatchSize = 500
+
+// Hub delivers the recorded task events to the watchers in this process.
+type Hub interface {
+	Publish(event model.TaskEvent, audience []int64)
+	Subscribe(userID int64) (<-chan model.TaskEvent, func())
+}
+
+// WatchTasks sends the changes of the user's tasks, read from the history
+// after afterSequence, until ctx is done. The hub and the watchPoll ticker
+// only tell when to read again.
+func (t *Task) WatchTasks(ctx context.Context, afterSequence int64, send func(change model.TaskChange) error) error {
+	const op = "tasks.watch"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	// Subscribing before the history is read leaves no gap between the two.
+	events, unsubscribe := t.hub.Subscribe(userID)
+	defer func() { unsubscribe() }()
+
+	last := afterSequence
+
+	if last == 0 {
+		var err error
+
+		last, err = t.eventTask.LastTaskEventID(ctx)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	var poll <-chan time.Time
+
+	if t.watchPoll > 0 {
+		ticker := time.NewTicker(t.watchPoll)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		var err error
+
+		if last, err = t.sendChanges(ctx, userID, last, send); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-poll:
+		case _, ok := <-events:
+			// The hub drops a subscriber that falls behind; the history
+			// has everything it missed.
+			if !ok {
+				unsubscribe()
+				events, unsubscribe = t.hub.Subscribe(userID)
+				continue
+			}
+
+			// One read of the history covers every signal pending.
+			for len(events) > 0 {
+				<-events
+			}
+		}
+	}
+}
+
+// sendChanges sends the changes recorded after the last one sent and returns
+// the sequence of the latest.
+func (t *Task) sendChanges(ctx context.Context, userID int64, last int64, send func(change model.TaskChange) error) (int64, error) {
+	for {
+		events, err := t.eventTask.ListUserTaskEvents(ctx, userID, last, replayBatchSize)
+
+		if err != nil {
+			return last, err
+		}
+
+		for _, event := range events {
+			if err := t.sendChange(ctx, event, userID, send); err != nil {
+				return last, err
+			}
+			last = event.ID
+		}
+
+		if len(events) < replayBatchSize {
+			return last, nil
+		}
+	}
+}
+
+// sendChange turns a history entry into the change a watcher sees. Created and
+// updated tasks are sent as they are now; one the user can no longer see is
+// skipped, its removal comes as an event of its own.
+func (t *Task) sendChange(ctx context.Context, event model.TaskEvent, userID int64, send func(change model.TaskChange) error) error {
+	change := model.TaskChange{Sequence: event.ID, TaskID: event.TaskID}
+
+	switch event.Kind {
+	case model.TaskDeleted, model.TaskPurged:
+		change.Kind = model.TaskChangeDeleted
+	case model.TaskCreated, model.TaskRestored:
+		change.Kind = model.TaskChangeCreated
+	default:
+		change.Kind = model.TaskChangeUpdated
+	}
+
+	if change.Kind != model.TaskChangeDeleted {
+		task, err := t.providerTask.GetTaskByID(ctx, event.TaskID, userID)
+
+		if errors.Is(err, storage.ErrTaskNotFound) || errors.Is(err, storage.ErrTaskAccessDenied) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		change.Task = &task
+	}
+
+	return send(change)
+}
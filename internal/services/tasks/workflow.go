@@ -0,0 +1,122 @@
+package tasks
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Workflow describes which status changes are allowed for a task.
+// Statuses are referenced by name so the rules survive reseeding the Statuses table.
+type Workflow struct {
+	initial     string
+	transitions map[string]map[string]bool
+	closed      []string
+	completed   string
+}
+
+func NewWorkflow(initial string, transitions map[string][]string, closed []string, completed string) Workflow {
+	w := Workflow{
+		initial:   initial,
+		closed:    closed,
+		completed: completed,
+	}
+
+	if transitions == nil {
+		return w
+	}
+
+	w.transitions = make(map[string]map[string]bool, len(transitions))
+
+	for from, targets := range transitions {
+		w.transitions[from] = make(map[string]bool, len(targets))
+		for _, to := range targets {
+			w.transitions[from][to] = true
+		}
+	}
+
+	return w
+}
+
+func (w Workflow) Initial() string {
+	return w.initial
+}
+
+// Closed lists the statuses in which a task counts as done, e.g. for overdue checks.
+func (w Workflow) Closed() []string {
+	return w.closed
+}
+
+// Completed is the status that marks a task as done rather than dropped;
+// entering it schedules the next occurrence of a recurring task.
+func (w Workflow) Completed() string {
+	return w.completed
+}
+
+// CanTransition reports whether a task may move from one status to another.
+// Statuses the workflow does not mention are entered from any non-final one.
+func (w Workflow) CanTransition(from string, to string) bool {
+	if from == to || w.transitions == nil {
+		return true
+	}
+
+	targets, known := w.transitions[from]
+
+	if !known {
+		return true
+	}
+
+	if _, managed := w.transitions[to]; !managed {
+		return len(targets) > 0
+	}
+
+	return targets[to]
+}
+
+// Validate checks the workflow against itself and against the global statuses
+// exists knows about.
+func (w Workflow) Validate(exists func(name string) (bool, error)) error {
+	if w.initial == "" {
+		return fmt.Errorf("workflow: initial status is not set")
+	}
+
+	if w.completed == "" {
+		return fmt.Errorf("workflow: completed status is not set")
+	}
+
+	if slices.Contains(w.closed, w.initial) {
+		return fmt.Errorf("workflow: initial status %q is closed", w.initial)
+	}
+
+	if !slices.Contains(w.closed, w.completed) {
+		return fmt.Errorf("workflow: completed status %q is not closed", w.completed)
+	}
+
+	names := append([]string{w.initial, w.completed}, w.closed...)
+
+	for from, targets := range w.transitions {
+		names = append(names, from)
+		for to := range targets {
+			names = append(names, to)
+		}
+	}
+
+	for _, name := range names {
+		if w.transitions != nil {
+			if _, ok := w.transitions[name]; !ok {
+				return fmt.Errorf("workflow: status %q has no transitions entry", name)
+			}
+		}
+
+		ok, err := exists(name)
+
+		if err != nil {
+			return fmt.Errorf("workflow: %w", err)
+		}
+
+		if !ok {
+			return fmt.Errorf("workflow: unknown status %q", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Purger periodically deletes the tasks that have been in the trash longer
+// than the retention period, together with their attachment files.
+type Purger struct {
+	log         *slog.Logger
+	purgerTrash PurgerTrash
+	blobs       BlobRemover
+	retention   time.Duration
+	interval    time.Duration
+	batchSize   int
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+func New(
+	log *slog.Logger,
+	purgerTrash PurgerTrash,
+	blobs BlobRemover,
+	retention time.Duration,
+	interval time.Duration,
+	batchSize int,
+) *Purger {
+	return &Purger{
+		log:         log,
+		purgerTrash: purgerTrash,
+		blobs:       blobs,
+		retention:   retention,
+		interval:    interval,
+		batchSize:   batchSize,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+type PurgerTrash interface {
+	PurgeTrash(ctx context.Context, before time.Time, limit int) (int, []string, error)
+}
+
+type BlobRemover interface {
+	Delete(ctx context.Context, key string) error
+}
+
+func (p *Purger) Run() {
+	const op = "trash.run"
+
+	log := p.log.With(slog.String("op", op))
+
+	log.Info("starting trash purger", slog.Duration("retention", p.retention), slog.Duration("interval", p.interval))
+
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Tick(context.Background(), time.Now().UTC()); err != nil {
+			log.Error("failed to purge trash", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick purges every task trashed more than the retention period before now,
+// one batch at a time.
+func (p *Purger) Tick(ctx context.Context, now time.Time) error {
+	const op = "trash.tick"
+
+	log := p.log.With(slog.String("op", op))
+
+	for {
+		purged, blobKeys, err := p.purgerTrash.PurgeTrash(ctx, now.Add(-p.retention), p.batchSize)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, key := range blobKeys {
+			if err := p.blobs.Delete(ctx, key); err != nil {
+				log.Warn("failed to delete blob", slog.String("key", key), slog.String("error", err.Error()))
+			}
+		}
+
+		if purged > 0 {
+			log.Info("purged trashed tasks", slog.Int("count", purged))
+		}
+
+		if purged < p.batchSize {
+			return nil
+		}
+	}
+}
+
+func (p *Purger) Stop() {
+	const op = "trash.stop"
+
+	p.log.With(slog.String("op", op)).Info("stopping trash purger")
+
+	close(p.stop)
+
+	<-p.done
+}
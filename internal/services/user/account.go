@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+type UpdaterUser interface {
+	UpdateUser(ctx context.Context, userID int64, user model.UpdateUser) error
+	DeleteUser(ctx context.Context, userID int64, fallbackStatus string, deletedAt time.Time) ([]string, error)
+}
+
+type BlobRemover interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// UpdateUser changes the name or the login of the signed-in user and returns
+// the updated profile.
+func (u *User) UpdateUser(ctx context.Context, update model.UpdateUser) (model.User, error) {
+	const op = "user.update"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.User{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	if err := u.updaterUser.UpdateUser(ctx, userID, update); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrUserExist):
+			log.Info("login already taken", slog.Int64("user_id", userID))
+			return model.User{}, fmt.Errorf("%s: %w", op, ErrUserExists)
+		case errors.Is(err, storage.ErrUserNotFound):
+			return model.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		log.Error("failed to update user", slog.String("error", err.Error()))
+		return model.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := u.FetchUser(ctx, userID)
+
+	if err != nil {
+		return model.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// DeleteAccount closes the account of the signed-in user once the password
+// is confirmed. Personal data is deleted; what the user shared in workspaces
+// stays, credited to a deleted user.
+func (u *User) DeleteAccount(ctx context.Context, password string) error {
+	const op = "user.delete_account"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	user, err := u.providerUser.GetUserByID(ctx, userID)
+
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword(user.PassHash, []byte(password)); err != nil {
+		log.Info("invalid password", slog.Int64("user_id", userID))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	blobKeys, err := u.updaterUser.DeleteUser(ctx, userID, u.initialStatus, time.Now().UTC())
+
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		log.Error("failed to delete user", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// The account is gone already; a blob left behind only wastes space.
+	for _, key := range blobKeys {
+		if err := u.blobs.Delete(ctx, key); err != nil {
+			log.Warn("failed to delete blob", slog.String("key", key), slog.String("error", err.Error()))
+		}
+	}
+
+	log.Info("account deleted", slog.Int64("user_id", userID), slog.Int("blobs", len(blobKeys)))
+
+	return nil
+}
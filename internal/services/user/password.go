@@ -0,0 +1,176 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidResetToken  = errors.New("invalid or expired password reset token")
+)
+
+const resetTokenBytes = 32
+
+type PasswordSaver interface {
+	UpdatePassword(ctx context.Context, userID int64, passHash []byte, keepSessionID string) error
+	SavePasswordReset(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	CheckPasswordReset(ctx context.Context, tokenHash string, now time.Time) (int64, error)
+	ResetPassword(ctx context.Context, tokenHash string, passHash []byte, now time.Time) (int64, error)
+}
+
+type Mailer interface {
+	Send(ctx context.Context, mail model.Mail) error
+}
+
+// ChangePassword sets a new password once the current one is confirmed and
+// signs the user out everywhere but in the calling session; the access tokens
+// of the other sessions stop working at once.
+func (u *User) ChangePassword(ctx context.Context, currentPassword string, newPassword string) error {
+	const op = "user.change_password"
+
+	log := u.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	sessionID, _ := ctx.Value("session_id").(string)
+
+	user, err := u.providerUser.GetUserByID(ctx, userID)
+
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword(user.PassHash, []byte(currentPassword)); err != nil {
+		log.Info("invalid current password", slog.Int64("user_id", userID))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	passHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := u.passwordSaver.UpdatePassword(ctx, userID, passHash, sessionID); err != nil {
+		log.Error("failed to update password", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("password changed, other sessions revoked", slog.Int64("user_id", userID))
+
+	return nil
+}
+
+// RequestPasswordReset mails the user a single-use token for ResetPassword.
+// An unknown login is not reported, so the call tells nobody which logins
+// exist.
+func (u *User) RequestPasswordReset(ctx context.Context, login string) error {
+	const op = "user.request_password_reset"
+
+	log := u.log.With(slog.String("op", op))
+
+	user, err := u.providerUser.GetUser(ctx, login)
+
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Info("password reset for unknown login")
+			return nil
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	raw := make([]byte, resetTokenBytes)
+
+	if _, err := rand.Read(raw); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	expiresAt := time.Now().UTC().Add(u.resetTokenTTL)
+
+	if err := u.passwordSaver.SavePasswordReset(ctx, user.ID, hashResetToken(token), expiresAt); err != nil {
+		log.Error("failed to save password reset", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	mail := model.Mail{
+		To:      user.Login,
+		Subject: "Reset your TickTask password",
+		Body: fmt.Sprintf("Hello, %s!\n\nUse this code to set a new password: %s\n\nThe code works once and expires at %s. "+
+			"If you did not ask for a reset, ignore this message.\n", user.Name, token, expiresAt.Format(time.RFC1123)),
+	}
+
+	if err := u.mailer.Send(ctx, mail); err != nil {
+		log.Error("failed to send password reset", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("password reset requested", slog.Int64("user_id", user.ID))
+
+	return nil
+}
+
+// ResetPassword sets a new password with a token from RequestPasswordReset
+// and signs the user out of every session.
+func (u *User) ResetPassword(ctx context.Context, token string, newPassword string) error {
+	const op = "user.reset_password"
+
+	log := u.log.With(slog.String("op", op))
+
+	fail := func(err error) error {
+		if errors.Is(err, storage.ErrResetTokenNotFound) {
+			log.Info("invalid password reset token")
+			return fmt.Errorf("%s: %w", op, ErrInvalidResetToken)
+		}
+		log.Error("failed to reset password", slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tokenHash := hashResetToken(token)
+	now := time.Now().UTC()
+
+	// A wrong token is turned away before the password is hashed, so guessing
+	// tokens costs the server no bcrypt rounds.
+	if _, err := u.passwordSaver.CheckPasswordReset(ctx, tokenHash, now); err != nil {
+		return fail(err)
+	}
+
+	passHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	userID, err := u.passwordSaver.ResetPassword(ctx, tokenHash, passHash, now)
+
+	if err != nil {
+		return fail(err)
+	}
+
+	log.Info("password reset, all sessions revoked", slog.Int64("user_id", userID))
+
+	return nil
+}
+
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -24,8 +24,14 @@ type User struct {
 	providerUser    ProviderUser
 	sessionSaver    SessionSaver
 	sessionRemover  SessionRemover
+	passwordSaver   PasswordSaver
+	mailer          Mailer
+	updaterUser     UpdaterUser
+	blobs           BlobRemover
 	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
+	resetTokenTTL   time.Duration
+	initialStatus   string
 }
 
 func New(
@@ -34,8 +40,14 @@ func New(
 	providerUser ProviderUser,
 	sessionSaver SessionSaver,
 	sessionRemover SessionRemover,
+	passwordSaver PasswordSaver,
+	mailer Mailer,
+	updaterUser UpdaterUser,
+	blobs BlobRemover,
 	accessTokenTTL time.Duration,
 	refreshTokenTTL time.Duration,
+	resetTokenTTL time.Duration,
+	initialStatus string,
 ) *User {
 	return &User{
 		log:             log,
@@ -43,8 +55,14 @@ func New(
 		providerUser:    providerUser,
 		sessionSaver:    sessionSaver,
 		sessionRemover:  sessionRemover,
+		passwordSaver:   passwordSaver,
+		mailer:          mailer,
+		updaterUser:     updaterUser,
+		blobs:           blobs,
 		accessTokenTTL:  accessTokenTTL,
 		refreshTokenTTL: refreshTokenTTL,
+		resetTokenTTL:   resetTokenTTL,
+		initialStatus:   initialStatus,
 	}
 }
 
@@ -0,0 +1,268 @@
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+var (
+	ErrWorkspaceNotFound     = errors.New("workspace not found")
+	ErrWorkspaceAccessDenied = errors.New("user is not a member of the workspace")
+	ErrPermissionDenied      = errors.New("workspace role does not allow this operation")
+	ErrInvalidRole           = errors.New("invalid role")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrMemberExists          = errors.New("user is already a member")
+	ErrMemberNotFound        = errors.New("member not found")
+)
+
+type Workspace struct {
+	log               *slog.Logger
+	saverWorkspace    SaverWorkspace
+	providerWorkspace ProviderWorkspace
+	memberWorkspace   MemberWorkspace
+}
+
+func New(
+	log *slog.Logger,
+	saverWorkspace SaverWorkspace,
+	providerWorkspace ProviderWorkspace,
+	memberWorkspace MemberWorkspace,
+) *Workspace {
+	return &Workspace{
+		log:               log,
+		saverWorkspace:    saverWorkspace,
+		providerWorkspace: providerWorkspace,
+		memberWorkspace:   memberWorkspace,
+	}
+}
+
+type SaverWorkspace interface {
+	SaveWorkspace(ctx context.Context, workspace model.Workspace) (int64, error)
+}
+
+type ProviderWorkspace interface {
+	GetWorkspace(ctx context.Context, workspaceID int64, userID int64) (model.Workspace, error)
+	GetWorkspaces(ctx context.Context, userID int64) ([]model.Workspace, error)
+}
+
+type MemberWorkspace interface {
+	MemberRole(ctx context.Context, workspaceID int64, userID int64) (model.Role, error)
+	GetMember(ctx context.Context, workspaceID int64, userID int64) (model.WorkspaceMember, error)
+	AddMember(ctx context.Context, workspaceID int64, login string, role model.Role) (model.WorkspaceMember, error)
+	RemoveMember(ctx context.Context, workspaceID int64, userID int64, actorID int64) error
+	UpdateMemberRole(ctx context.Context, workspaceID int64, userID int64, role model.Role) error
+}
+
+func (w *Workspace) CreateWorkspace(ctx context.Context, name string) (int64, error) {
+	const op = "workspace.create"
+
+	log := w.log.With(slog.String("op", op))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return 0, fmt.Errorf("Not found user_id in context")
+	}
+
+	id, err := w.saverWorkspace.SaveWorkspace(ctx, model.Workspace{
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+		OwnerID:   userID,
+	})
+
+	if err != nil {
+		log.Warn("failed to save workspace", slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (w *Workspace) FetchWorkspace(ctx context.Context, workspaceID int64) (model.Workspace, error) {
+	const op = "workspace.fetch"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.Workspace{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	workspace, err := w.providerWorkspace.GetWorkspace(ctx, workspaceID, userID)
+
+	if err != nil {
+		return model.Workspace{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return workspace, nil
+}
+
+func (w *Workspace) FetchWorkspaces(ctx context.Context) ([]model.Workspace, error) {
+	const op = "workspace.fetch_all"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return nil, fmt.Errorf("Not found user_id in context")
+	}
+
+	workspaces, err := w.providerWorkspace.GetWorkspaces(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return workspaces, nil
+}
+
+// InviteMember adds the user with the given login. Owners and admins may
+// invite, but only the owner may hand out the admin role.
+func (w *Workspace) InviteMember(ctx context.Context, workspaceID int64, login string, role model.Role) (model.WorkspaceMember, error) {
+	const op = "workspace.invite_member"
+
+	log := w.log.With(slog.String("op", op), slog.Int64("workspace_id", workspaceID))
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.WorkspaceMember{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	caller, err := w.memberWorkspace.MemberRole(ctx, workspaceID, userID)
+
+	if err != nil {
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if err := checkGrant(caller, "", role); err != nil {
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	member, err := w.memberWorkspace.AddMember(ctx, workspaceID, login, role)
+
+	if err != nil {
+		log.Warn("failed to add member", slog.String("error", err.Error()))
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return member, nil
+}
+
+// RemoveMember removes a member. Everyone but the owner may leave on their
+// own; removing others follows the same rules as changing their role.
+func (w *Workspace) RemoveMember(ctx context.Context, workspaceID int64, memberID int64) error {
+	const op = "workspace.remove_member"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return fmt.Errorf("Not found user_id in context")
+	}
+
+	caller, err := w.memberWorkspace.MemberRole(ctx, workspaceID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	target, err := w.memberWorkspace.GetMember(ctx, workspaceID, memberID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if target.Role == model.RoleOwner {
+		return fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	if memberID != userID {
+		if err := checkGrant(caller, target.Role, ""); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := w.memberWorkspace.RemoveMember(ctx, workspaceID, memberID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	return nil
+}
+
+func (w *Workspace) ChangeMemberRole(ctx context.Context, workspaceID int64, memberID int64, role model.Role) (model.WorkspaceMember, error) {
+	const op = "workspace.change_member_role"
+
+	userID, ok := ctx.Value("user_id").(int64)
+
+	if !ok {
+		return model.WorkspaceMember{}, fmt.Errorf("Not found user_id in context")
+	}
+
+	caller, err := w.memberWorkspace.MemberRole(ctx, workspaceID, userID)
+
+	if err != nil {
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	target, err := w.memberWorkspace.GetMember(ctx, workspaceID, memberID)
+
+	if err != nil {
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	if target.Role == model.RoleOwner {
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	if err := checkGrant(caller, target.Role, role); err != nil {
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := w.memberWorkspace.UpdateMemberRole(ctx, workspaceID, memberID, role); err != nil {
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storageError(err))
+	}
+
+	target.Role = role
+
+	return target, nil
+}
+
+// checkGrant decides whether the caller may move a member from one role to
+// another; an empty role stands for "not a member".
+func checkGrant(caller model.Role, from model.Role, to model.Role) error {
+	switch to {
+	case "", model.RoleAdmin, model.RoleMember, model.RoleViewer:
+	default:
+		return ErrInvalidRole
+	}
+
+	if !caller.CanManage() {
+		return ErrPermissionDenied
+	}
+
+	if (from == model.RoleAdmin || to == model.RoleAdmin) && caller != model.RoleOwner {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrWorkspaceNotFound):
+		return ErrWorkspaceNotFound
+	case errors.Is(err, storage.ErrWorkspaceAccessDenied):
+		return ErrWorkspaceAccessDenied
+	case errors.Is(err, storage.ErrUserNotFound):
+		return ErrUserNotFound
+	case errors.Is(err, storage.ErrMemberExist):
+		return ErrMemberExists
+	case errors.Is(err, storage.ErrMemberNotFound):
+		return ErrMemberNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,244 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+// DeleteUser deletes what belongs to the user alone and anonymizes the Users
+// row, so what the user shared in workspaces keeps an author. It returns the
+// keys of the blobs left without attachment.
+func (s *UserStorage) DeleteUser(ctx context.Context, userID int64, fallbackStatus string, deletedAt time.Time) ([]string, error) {
+	const op = "storage.sqlite.delete_user"
+
+	tx, err := begin(ctx, s.db)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	var active bool
+
+	if err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Users WHERE id = ? AND deleted_at IS NULL)", userID).Scan(&active); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !active {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	for _, query := range []string{
+		"DELETE FROM Sessions WHERE session_user_id = ?",
+		"DELETE FROM CalendarFeeds WHERE feed_user_id = ?",
+		"DELETE FROM PasswordResets WHERE reset_user_id = ?",
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	blobKeys, err := purgeTasks(ctx, tx, "SELECT id FROM Tasks WHERE workspace_id IS NULL AND task_user_id = ?", userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys, err := leaveWorkspaces(ctx, tx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	blobKeys = append(blobKeys, keys...)
+
+	if err := removeUserCatalogs(ctx, tx, userID, fallbackStatus); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// The history of the shared tasks written above still names the user by
+	// login; the account is anonymized last.
+	_, err = tx.ExecContext(ctx, `UPDATE Users SET login = '', name = 'Deleted user', hash_password = x'', deleted_at = ?
+    WHERE id = ?`, deletedAt.UTC(), userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return blobKeys, nil
+}
+
+// leaveWorkspaces ends the memberships of the user. Each workspace the user
+// owns is handed to an admin, else a member, else a viewer, whoever joined
+// first; with nobody left it is deleted.
+func leaveWorkspaces(ctx context.Context, tx dbtx, userID int64) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM Workspaces WHERE workspace_owner_id = ?", userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var owned []int64
+
+	for rows.Next() {
+		var id int64
+
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		owned = append(owned, id)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var blobKeys []string
+
+	for _, workspaceID := range owned {
+		var successorID int64
+
+		err := tx.QueryRowContext(ctx, `SELECT member_user_id FROM WorkspaceMembers
+    WHERE workspace_id = ? AND member_user_id <> ?
+    ORDER BY CASE role WHEN 'owner' THEN 0 WHEN 'admin' THEN 1 WHEN 'member' THEN 2 ELSE 3 END, rowid
+    LIMIT 1`, workspaceID, userID).Scan(&successorID)
+
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		if err == nil {
+			if _, err := tx.ExecContext(ctx, "UPDATE Workspaces SET workspace_owner_id = ? WHERE id = ?", successorID, workspaceID); err != nil {
+				return nil, err
+			}
+
+			_, err = tx.ExecContext(ctx, "UPDATE WorkspaceMembers SET role = 'owner' WHERE workspace_id = ? AND member_user_id = ?",
+				workspaceID, successorID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		// The members go first, so that their clients learn the tasks are gone.
+		if _, err := tx.ExecContext(ctx, "DELETE FROM WorkspaceMembers WHERE workspace_id = ?", workspaceID); err != nil {
+			return nil, err
+		}
+
+		keys, err := purgeTasks(ctx, tx, "SELECT id FROM Tasks WHERE workspace_id = ?", workspaceID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		blobKeys = append(blobKeys, keys...)
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM Workspaces WHERE id = ?", workspaceID); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM WorkspaceMembers WHERE member_user_id = ?", userID); err != nil {
+		return nil, err
+	}
+
+	taskIDs, err := queryIDs(ctx, tx, "SELECT task_id FROM TaskAssignees WHERE user_id = ?", userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = changeTasks(ctx, tx, taskIDs, assigneesField, userID, model.TaskUnassigned, func() error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM TaskAssignees WHERE user_id = ?", userID)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blobKeys, nil
+}
+
+// removeUserCatalogs deletes the labels, projects and statuses of the user,
+// detaching the workspace tasks that still use them.
+func removeUserCatalogs(ctx context.Context, tx dbtx, userID int64, fallbackStatus string) error {
+	var fallbackID int64
+
+	err := tx.QueryRowContext(ctx, "SELECT id FROM Statuses WHERE status = ? AND status_user_id IS NULL", fallbackStatus).Scan(&fallbackID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ErrStatusNotFound
+		}
+		return err
+	}
+
+	// The user's labels, projects and statuses may still be in use on the
+	// workspace tasks of others, whose history tells what became of them.
+	changes := []struct {
+		tasks string
+		field taskField
+		kind  model.TaskEventKind
+		apply string
+		args  []interface{}
+	}{
+		{"SELECT DISTINCT task_id FROM TaskLabels WHERE label_id IN (SELECT id FROM Labels WHERE label_user_id = ?)", labelsField, model.TaskUnlabeled,
+			"DELETE FROM TaskLabels WHERE label_id IN (SELECT id FROM Labels WHERE label_user_id = ?)", []interface{}{userID}},
+		{"SELECT id FROM Tasks WHERE project_id IN (SELECT id FROM Projects WHERE project_user_id = ?)", projectField, model.TaskMoved,
+			"UPDATE Tasks SET project_id = NULL WHERE project_id IN (SELECT id FROM Projects WHERE project_user_id = ?)", []interface{}{userID}},
+		{"SELECT id FROM Tasks WHERE task_status_id IN (SELECT id FROM Statuses WHERE status_user_id = ?)", statusField, model.TaskStatusChanged,
+			"UPDATE Tasks SET task_status_id = ? WHERE task_status_id IN (SELECT id FROM Statuses WHERE status_user_id = ?)", []interface{}{fallbackID, userID}},
+	}
+
+	for _, change := range changes {
+		taskIDs, err := queryIDs(ctx, tx, change.tasks, userID)
+
+		if err != nil {
+			return err
+		}
+
+		err = changeTasks(ctx, tx, taskIDs, change.field, userID, change.kind, func() error {
+			_, err := tx.ExecContext(ctx, change.apply, change.args...)
+			return err
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	queries := []struct {
+		query string
+		args  []interface{}
+	}{
+		{"DELETE FROM Labels WHERE label_user_id = ?", []interface{}{userID}},
+		{"DELETE FROM ProjectStatuses WHERE project_id IN (SELECT id FROM Projects WHERE project_user_id = ?)", []interface{}{userID}},
+		{"DELETE FROM Projects WHERE project_user_id = ?", []interface{}{userID}},
+		{"DELETE FROM ProjectStatuses WHERE status_id IN (SELECT id FROM Statuses WHERE status_user_id = ?)", []interface{}{userID}},
+		{"DELETE FROM Statuses WHERE status_user_id = ?", []interface{}{userID}},
+	}
+
+	for _, q := range queries {
+		if _, err := tx.ExecContext(ctx, q.query, q.args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"server/internal/storage"
+)
+
+// AssignTask adds the assignee to the task. A personal task can only be
+// assigned to its owner, a workspace task to members of the workspace.
+func (t *TaskStorage) AssignTask(ctx context.Context, taskID int64, assigneeID int64, userID int64) error {
+	const op = "storage.sqlite.assign_task"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskAccess(ctx, tx, taskID, userID, true); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var allowed bool
+
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Tasks WHERE id = ? AND "+taskAccess("Tasks", false)+")",
+		taskID, assigneeID, assigneeID).Scan(&allowed)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !allowed {
+		return fmt.Errorf("%s: %w", op, storage.ErrAssigneeAccessDenied)
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO TaskAssignees(task_id, user_id) VALUES (?, ?)", taskID, assigneeID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (t *TaskStorage) UnassignTask(ctx context.Context, taskID int64, assigneeID int64, userID int64) error {
+	const op = "storage.sqlite.unassign_task"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskAccess(ctx, tx, taskID, userID, true); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM TaskAssignees WHERE task_id = ? AND user_id = ?", taskID, assigneeID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
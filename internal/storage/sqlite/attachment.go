@@ -0,0 +1,187 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+)
+
+type AttachmentStorage struct {
+	db *sql.DB
+}
+
+const attachmentColumns = `a.id, a.task_id, a.file_name, a.content_type, a.size, a.checksum, a.blob_key, u.id, u.name, u.login, a.created_at`
+
+func NewAttachmentStorage(db *sql.DB) *AttachmentStorage {
+	return &AttachmentStorage{db: db}
+}
+
+func (a *AttachmentStorage) Stop() error {
+	return a.db.Close()
+}
+
+// CheckTaskWritable lets the caller refuse an upload before any bytes are stored.
+func (a *AttachmentStorage) CheckTaskWritable(ctx context.Context, taskID int64, userID int64) error {
+	const op = "storage.sqlite.check_task_writable"
+
+	if err := checkTaskAccess(ctx, a.db, taskID, userID, true); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (a *AttachmentStorage) SaveAttachment(ctx context.Context, attachment model.Attachment) (int64, error) {
+	const op = "storage.sqlite.save_attachment"
+
+	tx, err := begin(ctx, a.db)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskAccess(ctx, tx, attachment.TaskID, attachment.Uploader.ID, true); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, `INSERT INTO Attachments(task_id, uploader_id, file_name, content_type, size, checksum, blob_key, created_at)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, attachment.TaskID, attachment.Uploader.ID, attachment.FileName, attachment.ContentType,
+		attachment.Size, attachment.Checksum, attachment.BlobKey, attachment.CreatedAt.UTC())
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (a *AttachmentStorage) GetAttachment(ctx context.Context, attachmentID int64, userID int64) (model.Attachment, error) {
+	const op = "storage.sqlite.get_attachment"
+
+	row := conn(ctx, a.db).QueryRowContext(ctx, `SELECT `+attachmentColumns+` FROM Attachments a
+    INNER JOIN Users u ON u.id = a.uploader_id
+    INNER JOIN Tasks t ON t.id = a.task_id WHERE a.id = ? AND `+taskAccess("t", false), attachmentID, userID, userID)
+
+	attachment, err := scanAttachment(row)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Attachment{}, fmt.Errorf("%s: %w", op, missingAttachmentError(ctx, a.db, attachmentID))
+		}
+		return model.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attachment, nil
+}
+
+func (a *AttachmentStorage) GetAttachments(ctx context.Context, taskID int64, userID int64) ([]model.Attachment, error) {
+	const op = "storage.sqlite.get_attachments"
+
+	if err := checkTaskAccess(ctx, a.db, taskID, userID, false); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := conn(ctx, a.db).QueryContext(ctx, `SELECT `+attachmentColumns+` FROM Attachments a
+    INNER JOIN Users u ON u.id = a.uploader_id WHERE a.task_id = ? ORDER BY a.created_at, a.id`, taskID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var attachments []model.Attachment
+
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attachments, nil
+}
+
+// RemoveAttachment deletes the metadata and returns the blob key, so the
+// caller can drop the file itself.
+func (a *AttachmentStorage) RemoveAttachment(ctx context.Context, attachmentID int64, userID int64) (string, error) {
+	const op = "storage.sqlite.remove_attachment"
+
+	tx, err := begin(ctx, a.db)
+
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	var blobKey string
+
+	err = tx.QueryRowContext(ctx, `SELECT a.blob_key FROM Attachments a INNER JOIN Tasks t ON t.id = a.task_id
+    WHERE a.id = ? AND `+taskAccess("t", true), attachmentID, userID, userID).Scan(&blobKey)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, missingAttachmentError(ctx, tx, attachmentID))
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM Attachments WHERE id = ?", attachmentID); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return blobKey, nil
+}
+
+func scanAttachment(row rowScanner) (model.Attachment, error) {
+	var attachment model.Attachment
+
+	err := row.Scan(&attachment.ID, &attachment.TaskID, &attachment.FileName, &attachment.ContentType, &attachment.Size,
+		&attachment.Checksum, &attachment.BlobKey, &attachment.Uploader.ID, &attachment.Uploader.Name, &attachment.Uploader.Login,
+		&attachment.CreatedAt)
+
+	return attachment, err
+}
+
+// missingAttachmentError tells an attachment that does not exist apart from
+// one on a task the user may not reach.
+func missingAttachmentError(ctx context.Context, db queryRower, attachmentID int64) error {
+	var exists bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Attachments WHERE id = ?)", attachmentID).Scan(&exists)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return storage.ErrTaskAccessDenied
+	}
+
+	return storage.ErrAttachmentNotFound
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/storage"
+	"time"
+)
+
+type CalendarStorage struct {
+	db *sql.DB
+}
+
+func NewCalendarStorage(db *sql.DB) *CalendarStorage {
+	return &CalendarStorage{db: db}
+}
+
+func (c *CalendarStorage) Stop() error {
+	return c.db.Close()
+}
+
+// SaveCalendarFeed stores the token hash of the user's feed, replacing the
+// previous one, so the old feed link stops working.
+func (c *CalendarStorage) SaveCalendarFeed(ctx context.Context, userID int64, tokenHash string, createdAt time.Time) error {
+	const op = "storage.sqlite.save_calendar_feed"
+
+	req, err := conn(ctx, c.db).PrepareContext(ctx, `INSERT INTO CalendarFeeds(feed_user_id, token_hash, created_at) VALUES (?, ?, ?)
+    ON CONFLICT (feed_user_id) DO UPDATE SET token_hash = excluded.token_hash, created_at = excluded.created_at`)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	if _, err := req.ExecContext(ctx, userID, tokenHash, createdAt.UTC()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *CalendarStorage) RemoveCalendarFeed(ctx context.Context, userID int64) error {
+	const op = "storage.sqlite.remove_calendar_feed"
+
+	res, err := conn(ctx, c.db).ExecContext(ctx, "DELETE FROM CalendarFeeds WHERE feed_user_id = ?", userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrCalendarFeedNotFound)
+	}
+
+	return nil
+}
+
+// GetCalendarFeedUser returns the owner of the feed with the token hash.
+func (c *CalendarStorage) GetCalendarFeedUser(ctx context.Context, tokenHash string) (int64, error) {
+	const op = "storage.sqlite.get_calendar_feed_user"
+
+	var userID int64
+
+	err := conn(ctx, c.db).QueryRowContext(ctx, "SELECT feed_user_id FROM CalendarFeeds WHERE token_hash = ?", tokenHash).Scan(&userID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrCalendarFeedNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,209 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"strings"
+)
+
+func (t *TaskStorage) SaveChecklistItem(ctx context.Context, taskID int64, userID int64, text string) (model.ChecklistItem, error) {
+	const op = "storage.sqlite.save_checklist_item"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskAccess(ctx, tx, taskID, userID, true); err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	item := model.ChecklistItem{TaskID: taskID, Text: text}
+
+	err = tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(position), 0) + 1 FROM ChecklistItems WHERE task_id = ?", taskID).Scan(&item.Position)
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, "INSERT INTO ChecklistItems(task_id, text, position) VALUES (?, ?, ?)", taskID, text, item.Position)
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	item.ID, err = res.LastInsertId()
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return item, nil
+}
+
+func (t *TaskStorage) GetChecklist(ctx context.Context, taskID int64, userID int64) ([]model.ChecklistItem, error) {
+	const op = "storage.sqlite.get_checklist"
+
+	var items []model.ChecklistItem
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `SELECT c.id, c.task_id, c.text, c.done, c.position FROM ChecklistItems c
+    INNER JOIN Tasks t ON t.id = c.task_id WHERE c.task_id = ? AND `+taskAccess("t", false)+` ORDER BY c.position, c.id`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, taskID, userID, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.ChecklistItem
+
+		if err := rows.Scan(&item.ID, &item.TaskID, &item.Text, &item.Done, &item.Position); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+func (t *TaskStorage) ToggleChecklistItem(ctx context.Context, taskID int64, itemID int64, userID int64, done bool) (model.ChecklistItem, error) {
+	const op = "storage.sqlite.toggle_checklist_item"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskAccess(ctx, tx, taskID, userID, true); err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE ChecklistItems SET done = ? WHERE id = ? AND task_id = ?", done, itemID, taskID)
+
+	if err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var item model.ChecklistItem
+
+	err = tx.QueryRowContext(ctx, "SELECT id, task_id, text, done, position FROM ChecklistItems WHERE id = ? AND task_id = ?", itemID, taskID).
+		Scan(&item.ID, &item.TaskID, &item.Text, &item.Done, &item.Position)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, storage.ErrChecklistItemNotFound)
+		}
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.ChecklistItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return item, nil
+}
+
+func (t *TaskStorage) ReorderChecklistItems(ctx context.Context, taskID int64, userID int64, itemIDs []int64) error {
+	const op = "storage.sqlite.reorder_checklist_items"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskAccess(ctx, tx, taskID, userID, true); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	req, err := tx.PrepareContext(ctx, "UPDATE ChecklistItems SET position = ? WHERE id = ? AND task_id = ?")
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	for i, itemID := range itemIDs {
+		res, err := req.ExecContext(ctx, i+1, itemID, taskID)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		rows, err := res.RowsAffected()
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if rows == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrChecklistItemNotFound)
+		}
+	}
+
+	// Items left out of the list keep their relative order after the listed ones.
+	args := []interface{}{len(itemIDs), taskID}
+	for _, itemID := range itemIDs {
+		args = append(args, itemID)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE ChecklistItems SET position = position + ?
+    WHERE task_id = ? AND id NOT IN (?`+strings.Repeat(", ?", len(itemIDs)-1)+`)`, args...)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkTaskAccess makes sure the task exists and the user may see it or,
+// with write, change it.
+func checkTaskAccess(ctx context.Context, db queryRower, taskID int64, userID int64, write bool) error {
+	var allowed bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Tasks WHERE id = ? AND "+taskAccess("Tasks", write)+")", taskID, userID, userID).Scan(&allowed)
+
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return missingTaskError(ctx, db, taskID)
+	}
+
+	return nil
+}
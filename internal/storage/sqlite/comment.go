@@ -0,0 +1,202 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+type CommentStorage struct {
+	db *sql.DB
+}
+
+const commentColumns = `cm.id, cm.task_id, u.id, u.name, u.login, cm.body, cm.created_at, cm.updated_at`
+
+func NewCommentStorage(db *sql.DB) *CommentStorage {
+	return &CommentStorage{db: db}
+}
+
+func (c *CommentStorage) Stop() error {
+	return c.db.Close()
+}
+
+// SaveComment stores the comment; anyone who can see the task may comment on it.
+func (c *CommentStorage) SaveComment(ctx context.Context, comment model.Comment) (int64, error) {
+	const op = "storage.sqlite.save_comment"
+
+	tx, err := begin(ctx, c.db)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskAccess(ctx, tx, comment.TaskID, comment.Author.ID, false); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, "INSERT INTO Comments(task_id, author_id, body, created_at) VALUES (?, ?, ?, ?)",
+		comment.TaskID, comment.Author.ID, comment.Body, comment.CreatedAt.UTC())
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (c *CommentStorage) GetComment(ctx context.Context, commentID int64, userID int64) (model.Comment, error) {
+	const op = "storage.sqlite.get_comment"
+
+	row := conn(ctx, c.db).QueryRowContext(ctx, `SELECT `+commentColumns+` FROM Comments cm
+    INNER JOIN Users u ON u.id = cm.author_id
+    INNER JOIN Tasks t ON t.id = cm.task_id WHERE cm.id = ? AND `+taskAccess("t", false), commentID, userID, userID)
+
+	comment, err := scanComment(row)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Comment{}, fmt.Errorf("%s: %w", op, missingCommentError(ctx, c.db, commentID))
+		}
+		return model.Comment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return comment, nil
+}
+
+// GetComments returns the comments of the task oldest first, starting after the cursor.
+func (c *CommentStorage) GetComments(ctx context.Context, taskID int64, userID int64, after *model.CommentCursor, limit int) ([]model.Comment, error) {
+	const op = "storage.sqlite.get_comments"
+
+	if err := checkTaskAccess(ctx, c.db, taskID, userID, false); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `SELECT ` + commentColumns + ` FROM Comments cm
+    INNER JOIN Users u ON u.id = cm.author_id WHERE cm.task_id = ?`
+	args := []interface{}{taskID}
+
+	if after != nil {
+		query += " AND (cm.created_at > ? OR (cm.created_at = ? AND cm.id > ?))"
+		args = append(args, after.CreatedAt.UTC(), after.CreatedAt.UTC(), after.ID)
+	}
+
+	query += " ORDER BY cm.created_at, cm.id LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := conn(ctx, c.db).QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var comments []model.Comment
+
+	for rows.Next() {
+		comment, err := scanComment(rows)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return comments, nil
+}
+
+// UpdateComment changes the text of the comment; only its author may do that.
+func (c *CommentStorage) UpdateComment(ctx context.Context, commentID int64, userID int64, body string, updatedAt time.Time) error {
+	const op = "storage.sqlite.update_comment"
+
+	res, err := conn(ctx, c.db).ExecContext(ctx, `UPDATE Comments SET body = ?, updated_at = ?
+    WHERE id = ? AND author_id = ? AND task_id IN (SELECT t.id FROM Tasks t WHERE `+taskAccess("t", false)+`)`,
+		body, updatedAt.UTC(), commentID, userID, userID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingCommentError(ctx, c.db, commentID))
+	}
+
+	return nil
+}
+
+// RemoveComment deletes the comment when the user is its author or owns the task.
+func (c *CommentStorage) RemoveComment(ctx context.Context, commentID int64, userID int64) error {
+	const op = "storage.sqlite.remove_comment"
+
+	res, err := conn(ctx, c.db).ExecContext(ctx, `DELETE FROM Comments WHERE id = ? AND task_id IN (SELECT t.id FROM Tasks t WHERE `+taskAccess("t", false)+`)
+    AND (author_id = ? OR task_id IN (SELECT id FROM Tasks WHERE task_user_id = ?))`,
+		commentID, userID, userID, userID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingCommentError(ctx, c.db, commentID))
+	}
+
+	return nil
+}
+
+func scanComment(row rowScanner) (model.Comment, error) {
+	var comment model.Comment
+
+	err := row.Scan(&comment.ID, &comment.TaskID, &comment.Author.ID, &comment.Author.Name, &comment.Author.Login,
+		&comment.Body, &comment.CreatedAt, &comment.UpdatedAt)
+
+	return comment, err
+}
+
+// missingCommentError tells a comment that does not exist apart from one
+// the user may not touch.
+func missingCommentError(ctx context.Context, db queryRower, commentID int64) error {
+	var exists bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Comments WHERE id = ?)", commentID).Scan(&exists)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return storage.ErrCommentAccessDenied
+	}
+
+	return storage.ErrCommentNotFound
+}
@@ -0,0 +1,223 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+)
+
+type TaskEventStorage struct {
+	db *sql.DB
+}
+
+const taskEventColumns = `e.id, e.task_id, e.actor_id, COALESCE(u.name, ''), COALESCE(u.login, ''), e.kind, e.changes, e.created_at`
+
+func NewTaskEventStorage(db *sql.DB) *TaskEventStorage {
+	return &TaskEventStorage{db: db}
+}
+
+func (e *TaskEventStorage) Stop() error {
+	return e.db.Close()
+}
+
+func (e *TaskEventStorage) SaveTaskEvent(ctx context.Context, event model.TaskEvent) (int64, error) {
+	const op = "storage.sqlite.save_task_event"
+
+	id, err := saveTaskEvent(ctx, conn(ctx, e.db), event)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func saveTaskEvent(ctx context.Context, db dbtx, event model.TaskEvent) (int64, error) {
+	changes := event.Changes
+	if changes == nil {
+		changes = []model.FieldChange{}
+	}
+
+	raw, err := json.Marshal(changes)
+
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := db.ExecContext(ctx, "INSERT INTO TaskEvents(task_id, actor_id, kind, changes, created_at) VALUES (?, ?, ?, ?, ?)",
+		event.TaskID, event.Actor.ID, string(event.Kind), string(raw), event.CreatedAt.UTC())
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+// GetTaskEvents returns the history of the task oldest first, starting after
+// the cursor. The history stays readable while the task is in the trash.
+func (e *TaskEventStorage) GetTaskEvents(ctx context.Context, taskID int64, userID int64, after *model.TaskEventCursor, limit int) ([]model.TaskEvent, error) {
+	const op = "storage.sqlite.get_task_events"
+
+	var visible bool
+
+	err := conn(ctx, e.db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Tasks t WHERE t.id = ? AND "+taskOwnership("t", false)+")",
+		taskID, userID, userID).Scan(&visible)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !visible {
+		var exists bool
+
+		if err := conn(ctx, e.db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Tasks WHERE id = ?)", taskID).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if exists {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTaskAccessDenied)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrTaskNotFound)
+	}
+
+	query := `SELECT ` + taskEventColumns + ` FROM TaskEvents e
+    LEFT JOIN Users u ON u.id = e.actor_id WHERE e.task_id = ?`
+	args := []interface{}{taskID}
+
+	if after != nil {
+		query += " AND e.id > ?"
+		args = append(args, after.ID)
+	}
+
+	query += " ORDER BY e.id LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := conn(ctx, e.db).QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var events []model.TaskEvent
+
+	for rows.Next() {
+		event, err := scanTaskEvent(rows)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// ListUserTaskEvents returns the events after the given one on every task the
+// user may see, trashed ones included, oldest first. A purged task is matched
+// through its tombstone so its history still reaches the watchers.
+func (e *TaskEventStorage) ListUserTaskEvents(ctx context.Context, userID int64, afterID int64, limit int) ([]model.TaskEvent, error) {
+	const op = "storage.sqlite.list_user_task_events"
+
+	rows, err := conn(ctx, e.db).QueryContext(ctx, `SELECT `+taskEventColumns+` FROM TaskEvents e
+    LEFT JOIN Tasks t ON t.id = e.task_id
+    LEFT JOIN TaskTombstones tt ON tt.task_id = e.task_id AND t.id IS NULL
+    LEFT JOIN Users u ON u.id = e.actor_id WHERE e.id > ? AND (`+taskOwnership("t", false)+` OR `+taskOwnership("tt", false)+`)
+    ORDER BY e.id LIMIT ?`,
+		afterID, userID, userID, userID, userID, limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var events []model.TaskEvent
+
+	for rows.Next() {
+		event, err := scanTaskEvent(rows)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// LastTaskEventID returns the id of the latest event recorded, zero when
+// there is none.
+func (e *TaskEventStorage) LastTaskEventID(ctx context.Context) (int64, error) {
+	const op = "storage.sqlite.last_task_event_id"
+
+	var id int64
+
+	if err := conn(ctx, e.db).QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) FROM TaskEvents").Scan(&id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// TaskAudience returns the users who may see the task: its owner for a
+// personal task, the workspace members otherwise.
+func (e *TaskEventStorage) TaskAudience(ctx context.Context, taskID int64) ([]int64, error) {
+	const op = "storage.sqlite.task_audience"
+
+	rows, err := conn(ctx, e.db).QueryContext(ctx, `SELECT task_user_id FROM Tasks WHERE id = ? AND workspace_id IS NULL
+    UNION SELECT wm.member_user_id FROM WorkspaceMembers wm INNER JOIN Tasks t ON t.workspace_id = wm.workspace_id WHERE t.id = ?`,
+		taskID, taskID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var audience []int64
+
+	for rows.Next() {
+		var userID int64
+
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		audience = append(audience, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return audience, nil
+}
+
+func scanTaskEvent(row rowScanner) (model.TaskEvent, error) {
+	var event model.TaskEvent
+	var changes string
+
+	err := row.Scan(&event.ID, &event.TaskID, &event.Actor.ID, &event.Actor.Name, &event.Actor.Login,
+		&event.Kind, &changes, &event.CreatedAt)
+
+	if err != nil {
+		return model.TaskEvent{}, err
+	}
+
+	if err := json.Unmarshal([]byte(changes), &event.Changes); err != nil {
+		return model.TaskEvent{}, err
+	}
+
+	return event, nil
+}
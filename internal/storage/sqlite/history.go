@@ -0,0 +1,162 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"server/internal/domain/model"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Changes made on behalf of a label, a status, a project or a membership are
+// recorded here rather than by the task service.
+
+// taskField reads a field of the task formatted as the history keeps it.
+type taskField struct {
+	name  string
+	value func(ctx context.Context, db dbtx, taskID int64) (string, error)
+}
+
+var (
+	statusField = taskField{"status", func(ctx context.Context, db dbtx, taskID int64) (string, error) {
+		return queryValue(ctx, db, `SELECT COALESCE(s.status, '') FROM Tasks t
+    LEFT JOIN Statuses s ON s.id = t.task_status_id WHERE t.id = ?`, taskID)
+	}}
+
+	projectField = taskField{"project_id", func(ctx context.Context, db dbtx, taskID int64) (string, error) {
+		var projectID sql.NullInt64
+
+		if err := db.QueryRowContext(ctx, "SELECT project_id FROM Tasks WHERE id = ?", taskID).Scan(&projectID); err != nil {
+			return "", err
+		}
+
+		if !projectID.Valid || projectID.Int64 == 0 {
+			return "", nil
+		}
+
+		return strconv.FormatInt(projectID.Int64, 10), nil
+	}}
+
+	labelsField = taskField{"labels", func(ctx context.Context, db dbtx, taskID int64) (string, error) {
+		return queryList(ctx, db, `SELECT l.name FROM TaskLabels tl
+    INNER JOIN Labels l ON l.id = tl.label_id WHERE tl.task_id = ?`, taskID)
+	}}
+
+	assigneesField = taskField{"assignees", func(ctx context.Context, db dbtx, taskID int64) (string, error) {
+		return queryList(ctx, db, `SELECT u.login FROM TaskAssignees ta
+    INNER JOIN Users u ON u.id = ta.user_id WHERE ta.task_id = ?`, taskID)
+	}}
+)
+
+// changeTasks runs apply, which changes the field of the tasks, and writes a
+// history entry of the kind for every task whose field it did change.
+func changeTasks(ctx context.Context, db dbtx, taskIDs []int64, field taskField, actorID int64, kind model.TaskEventKind, apply func() error) error {
+	before := make([]string, len(taskIDs))
+
+	for i, taskID := range taskIDs {
+		value, err := field.value(ctx, db, taskID)
+
+		if err != nil {
+			return err
+		}
+
+		before[i] = value
+	}
+
+	if err := apply(); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for i, taskID := range taskIDs {
+		after, err := field.value(ctx, db, taskID)
+
+		if err != nil {
+			return err
+		}
+
+		if after == before[i] {
+			continue
+		}
+
+		_, err = saveTaskEvent(ctx, db, model.TaskEvent{
+			TaskID:    taskID,
+			Actor:     model.TodosUser{ID: actorID},
+			Kind:      kind,
+			Changes:   []model.FieldChange{{Field: field.name, Before: before[i], After: after}},
+			CreatedAt: now,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryIDs returns the ids the query selects.
+func queryIDs(ctx context.Context, db dbtx, query string, args ...interface{}) ([]int64, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+
+	for rows.Next() {
+		var id int64
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func queryValue(ctx context.Context, db dbtx, query string, args ...interface{}) (string, error) {
+	var value string
+
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+// queryList returns the values the query selects sorted and joined, the way
+// the task service formats list fields.
+func queryList(ctx context.Context, db dbtx, query string, args ...interface{}) (string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var values []string
+
+	for rows.Next() {
+		var value string
+
+		if err := rows.Scan(&value); err != nil {
+			return "", err
+		}
+		values = append(values, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	sort.Strings(values)
+
+	return strings.Join(values, ", "), nil
+}
@@ -0,0 +1,172 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	sqlite3 "github.com/mutecomm/go-sqlcipher/v4"
+	"server/internal/domain/model"
+	"server/internal/storage"
+)
+
+type LabelStorage struct {
+	db *sql.DB
+}
+
+func NewLabelStorage(db *sql.DB) *LabelStorage {
+	return &LabelStorage{db: db}
+}
+
+func (l *LabelStorage) Stop() error {
+	return l.db.Close()
+}
+
+func (l *LabelStorage) SaveLabel(ctx context.Context, label model.Label) (int64, error) {
+	const op = "storage.sqlite.save_label"
+
+	req, err := conn(ctx, l.db).PrepareContext(ctx, "INSERT INTO Labels(name, color, label_user_id) VALUES (?, ?, ?)")
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	res, err := req.ExecContext(ctx, label.Name, label.Color, label.UserID)
+
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && errors.Is(sqliteErr.ExtendedCode, sqlite3.ErrConstraintUnique) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrLabelExist)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (l *LabelStorage) GetLabels(ctx context.Context, userID int64) ([]model.Label, error) {
+	const op = "storage.sqlite.get_labels"
+
+	var labels []model.Label
+
+	req, err := conn(ctx, l.db).PrepareContext(ctx, "SELECT id, name, color, label_user_id FROM Labels WHERE label_user_id = ? ORDER BY name")
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var label model.Label
+
+		if err := rows.Scan(&label.ID, &label.Name, &label.Color, &label.UserID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		labels = append(labels, label)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return labels, nil
+}
+
+func (l *LabelStorage) AttachLabel(ctx context.Context, taskID int64, labelID int64, userID int64) error {
+	const op = "storage.sqlite.attach_label"
+
+	tx, err := begin(ctx, l.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskLabel(ctx, tx, taskID, labelID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = changeTasks(ctx, tx, []int64{taskID}, labelsField, userID, model.TaskLabeled, func() error {
+		_, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO TaskLabels(task_id, label_id) VALUES (?, ?)", taskID, labelID)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (l *LabelStorage) DetachLabel(ctx context.Context, taskID int64, labelID int64, userID int64) error {
+	const op = "storage.sqlite.detach_label"
+
+	tx, err := begin(ctx, l.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTaskLabel(ctx, tx, taskID, labelID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = changeTasks(ctx, tx, []int64{taskID}, labelsField, userID, model.TaskUnlabeled, func() error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM TaskLabels WHERE task_id = ? AND label_id = ?", taskID, labelID)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkTaskLabel makes sure both the task and the label belong to the user.
+func checkTaskLabel(ctx context.Context, tx dbtx, taskID int64, labelID int64, userID int64) error {
+	if err := checkTaskAccess(ctx, tx, taskID, userID, true); err != nil {
+		return err
+	}
+
+	var owned bool
+
+	err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Labels WHERE id = ? AND label_user_id = ?)", labelID, userID).Scan(&owned)
+
+	if err != nil {
+		return err
+	}
+
+	if !owned {
+		return storage.ErrLabelNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	sqlite3 "github.com/mutecomm/go-sqlcipher/v4"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"strings"
+)
+
+type ProjectStorage struct {
+	db *sql.DB
+}
+
+func NewProjectStorage(db *sql.DB) *ProjectStorage {
+	return &ProjectStorage{db: db}
+}
+
+func (p *ProjectStorage) Stop() error {
+	return p.db.Close()
+}
+
+// SaveProject stores the project together with its status set.
+func (p *ProjectStorage) SaveProject(ctx context.Context, project model.Project, statusIDs []int64) (int64, error) {
+	const op = "storage.sqlite.save_project"
+
+	tx, err := begin(ctx, p.db)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "INSERT INTO Projects(name, description, created_at, project_user_id) VALUES (?, ?, ?, ?)",
+		project.Name, project.Description, project.CreatedAt, project.UserID)
+
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && errors.Is(sqliteErr.ExtendedCode, sqlite3.ErrConstraintUnique) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrProjectExist)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	req, err := tx.PrepareContext(ctx, "INSERT OR IGNORE INTO ProjectStatuses(project_id, status_id) VALUES (?, ?)")
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	for _, statusID := range statusIDs {
+		if _, err := req.ExecContext(ctx, id, statusID); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (p *ProjectStorage) GetProject(ctx context.Context, projectID int64, userID int64) (model.Project, error) {
+	const op = "storage.sqlite.get_project"
+
+	var project model.Project
+
+	err := conn(ctx, p.db).QueryRowContext(ctx, `SELECT id, name, description, created_at, project_user_id FROM Projects
+    WHERE id = ? AND project_user_id = ?`, projectID, userID).
+		Scan(&project.ID, &project.Name, &project.Description, &project.CreatedAt, &project.UserID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Project{}, fmt.Errorf("%s: %w", op, missingProjectError(ctx, p.db, projectID))
+		}
+		return model.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	projects := []model.Project{project}
+
+	if err := p.loadStatuses(ctx, projects); err != nil {
+		return model.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return projects[0], nil
+}
+
+func (p *ProjectStorage) GetProjects(ctx context.Context, userID int64) ([]model.Project, error) {
+	const op = "storage.sqlite.get_projects"
+
+	var projects []model.Project
+
+	req, err := conn(ctx, p.db).PrepareContext(ctx, `SELECT id, name, description, created_at, project_user_id FROM Projects
+    WHERE project_user_id = ? ORDER BY name, id`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var project model.Project
+
+		if err := rows.Scan(&project.ID, &project.Name, &project.Description, &project.CreatedAt, &project.UserID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		projects = append(projects, project)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := p.loadStatuses(ctx, projects); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return projects, nil
+}
+
+func (p *ProjectStorage) UpdateProject(ctx context.Context, projectID int64, userID int64, project model.UpdateProject) error {
+	const op = "storage.sqlite.update_project"
+
+	var columns []string
+	var args []interface{}
+
+	if project.Name != nil {
+		columns = append(columns, "name = ?")
+		args = append(args, *project.Name)
+	}
+
+	if project.Description != nil {
+		columns = append(columns, "description = ?")
+		args = append(args, *project.Description)
+	}
+
+	if len(columns) == 0 {
+		return fmt.Errorf("%s: nothing to update", op)
+	}
+
+	args = append(args, projectID, userID)
+
+	req, err := conn(ctx, p.db).PrepareContext(ctx, "UPDATE Projects SET "+strings.Join(columns, ", ")+" WHERE id = ? AND project_user_id = ?")
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	res, err := req.ExecContext(ctx, args...)
+
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && errors.Is(sqliteErr.ExtendedCode, sqlite3.ErrConstraintUnique) {
+			return fmt.Errorf("%s: %w", op, storage.ErrProjectExist)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingProjectError(ctx, p.db, projectID))
+	}
+
+	return nil
+}
+
+// RemoveProject deletes the project; its tasks stay and drop out of any project.
+func (p *ProjectStorage) RemoveProject(ctx context.Context, projectID int64, userID int64) error {
+	const op = "storage.sqlite.remove_project"
+
+	tx, err := begin(ctx, p.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM Projects WHERE id = ? AND project_user_id = ?", projectID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingProjectError(ctx, tx, projectID))
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM ProjectStatuses WHERE project_id = ?", projectID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	taskIDs, err := queryIDs(ctx, tx, "SELECT id FROM Tasks WHERE project_id = ?", projectID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = changeTasks(ctx, tx, taskIDs, projectField, userID, model.TaskMoved, func() error {
+		_, err := tx.ExecContext(ctx, "UPDATE Tasks SET project_id = NULL WHERE project_id = ?", projectID)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// loadStatuses fills the status set of the given projects with a single query.
+func (p *ProjectStorage) loadStatuses(ctx context.Context, projects []model.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]int, len(projects))
+	args := make([]interface{}, 0, len(projects))
+
+	for i, project := range projects {
+		index[project.ID] = i
+		args = append(args, project.ID)
+	}
+
+	rows, err := conn(ctx, p.db).QueryContext(ctx, `SELECT ps.project_id, s.id, s.status, COALESCE(s.status_user_id, 0), s.position FROM ProjectStatuses ps
+    INNER JOIN Statuses s ON s.id = ps.status_id WHERE ps.project_id IN (?`+strings.Repeat(", ?", len(args)-1)+`) ORDER BY s.position, s.id`, args...)
+
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var projectID int64
+		var status model.Status
+
+		if err := rows.Scan(&projectID, &status.ID, &status.Status, &status.UserID, &status.Position); err != nil {
+			return err
+		}
+
+		i := index[projectID]
+		projects[i].Statuses = append(projects[i].Statuses, status)
+	}
+
+	return rows.Err()
+}
+
+// missingProjectError tells a project that does not exist apart from one
+// owned by another user.
+func missingProjectError(ctx context.Context, db queryRower, projectID int64) error {
+	var exists bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Projects WHERE id = ?)", projectID).Scan(&exists)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return storage.ErrProjectAccessDenied
+	}
+
+	return storage.ErrProjectNotFound
+}
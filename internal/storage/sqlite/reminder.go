@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"server/internal/domain/model"
+	"time"
+)
+
+func (t *TaskStorage) DueReminders(ctx context.Context, now time.Time, limit int) ([]model.Reminder, error) {
+	const op = "storage.sqlite.due_reminders"
+
+	var reminders []model.Reminder
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `SELECT id, task_user_id, title, due_at, remind_at, remind_attempts FROM Tasks
+    WHERE deleted_at IS NULL AND reminded_at IS NULL AND remind_at IS NOT NULL AND remind_at <= ?
+    AND (remind_retry_at IS NULL OR remind_retry_at <= ?) ORDER BY remind_at, id LIMIT ?`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, now.UTC(), now.UTC(), limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reminder model.Reminder
+
+		err = rows.Scan(&reminder.TaskID, &reminder.UserID, &reminder.Title, &reminder.DueAt, &reminder.RemindAt, &reminder.Attempts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reminders, nil
+}
+
+func (t *TaskStorage) MarkReminded(ctx context.Context, taskID int64, remindAt time.Time, at time.Time) error {
+	const op = "storage.sqlite.mark_reminded"
+
+	// remind_at is part of the condition so a reminder rescheduled while it was
+	// being delivered is not marked as sent.
+	_, err := conn(ctx, t.db).ExecContext(ctx, "UPDATE Tasks SET reminded_at = ? WHERE id = ? AND remind_at = ?", at.UTC(), taskID, remindAt.UTC())
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeferReminder counts a failed delivery and keeps the reminder out of
+// DueReminders until retryAt.
+func (t *TaskStorage) DeferReminder(ctx context.Context, taskID int64, remindAt time.Time, retryAt time.Time) error {
+	const op = "storage.sqlite.defer_reminder"
+
+	_, err := conn(ctx, t.db).ExecContext(ctx, "UPDATE Tasks SET remind_attempts = remind_attempts + 1, remind_retry_at = ? WHERE id = ? AND remind_at = ?",
+		retryAt.UTC(), taskID, remindAt.UTC())
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
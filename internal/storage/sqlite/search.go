@@ -0,0 +1,116 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"server/internal/domain/model"
+	"strings"
+	"unicode"
+)
+
+// FTS5 wraps the matches in these private use characters rather than in the
+// tags themselves, so the task text can be HTML-escaped before the tags go in.
+// Text that carries the characters itself can at worst gain stray <mark> tags.
+const (
+	matchOpen  = "\ue000"
+	matchClose = "\ue001"
+)
+
+var highlighter = strings.NewReplacer(matchOpen, "<mark>", matchClose, "</mark>")
+
+// highlight returns text with markup escaped and the matches wrapped in <mark>.
+func highlight(text string) string {
+	return highlighter.Replace(html.EscapeString(text))
+}
+
+func (t *TaskStorage) SearchTasks(ctx context.Context, userID int64, query string, limit int) ([]model.TaskSearchResult, error) {
+	const op = "storage.sqlite.search_tasks"
+
+	var results []model.TaskSearchResult
+
+	match := ftsQuery(query)
+
+	if match == "" {
+		return nil, nil
+	}
+
+	// bm25 weights: a hit in the title counts ten times more than one in the body.
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `SELECT `+taskColumns+`,
+    highlight(TasksSearch, 0, ?, ?), snippet(TasksSearch, 1, ?, ?, '…', 12), bm25(TasksSearch, 10.0, 1.0) AS rank
+    FROM TasksSearch
+    INNER JOIN Tasks t ON t.id = TasksSearch.rowid
+    `+taskJoins+`
+    WHERE TasksSearch MATCH ? AND `+taskAccess("t", false)+` ORDER BY rank LIMIT ?`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, matchOpen, matchClose, matchOpen, matchClose, match, userID, userID, limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result model.TaskSearchResult
+		var snippet *string
+
+		result.Task, err = scanTask(rows, &result.TitleHighlight, &snippet, &result.Rank)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result.TitleHighlight = highlight(result.TitleHighlight)
+		if snippet != nil {
+			result.BodySnippet = highlight(*snippet)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks := make([]model.Task, len(results))
+
+	for i := range results {
+		tasks[i] = results[i].Task
+	}
+
+	if err := t.loadRelated(ctx, tasks); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range results {
+		results[i].Task = tasks[i]
+	}
+
+	return results, nil
+}
+
+// ftsQuery turns free user input into an FTS5 expression: every word becomes a
+// quoted phrase, so operators and stray quotes cannot break the query, and the
+// last word is matched as a prefix to support search-as-you-type.
+func ftsQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	if len(words) == 0 {
+		return ""
+	}
+
+	terms := make([]string, len(words))
+
+	for i, word := range words {
+		terms[i] = `"` + word + `"`
+	}
+
+	terms[len(terms)-1] += "*"
+
+	return strings.Join(terms, " ")
+}
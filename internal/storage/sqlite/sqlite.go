@@ -0,0 +1,27 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Open opens the database every storage shares, with foreign keys enforced
+// and writers waiting for the lock instead of failing.
+func Open(storagePath string) (*sql.DB, error) {
+	const op = "storage.sqlite.open"
+
+	separator := "?"
+
+	if strings.Contains(storagePath, "?") {
+		separator = "&"
+	}
+
+	db, err := sql.Open("sqlite3", storagePath+separator+"_foreign_keys=on&_busy_timeout=5000&_txlock=immediate")
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return db, nil
+}
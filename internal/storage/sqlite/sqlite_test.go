@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const migrationsPath = "../../../migrations"
+
+// newTestDB returns the path of a fresh database with every up migration
+// applied in order, as the migrator would leave it.
+func newTestDB(t *testing.T) string {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(migrationsPath, "*.up.sql"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version := func(path string) int {
+		n, _ := strconv.Atoi(strings.SplitN(filepath.Base(path), "_", 2)[0])
+		return n
+	}
+
+	sort.Slice(files, func(i, j int) bool { return version(files[i]) < version(files[j]) })
+
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	db, err := sql.Open("sqlite3", path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	for _, file := range files {
+		migration, err := os.ReadFile(file)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := db.Exec(string(migration)); err != nil {
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				t.Fatalf("%s: %v; run the tests with -tags sqlite_fts5", filepath.Base(file), err)
+			}
+			t.Fatalf("%s: %v", filepath.Base(file), err)
+		}
+	}
+
+	return path
+}
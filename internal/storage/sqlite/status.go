@@ -0,0 +1,292 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	sqlite3 "github.com/mutecomm/go-sqlcipher/v4"
+	"server/internal/domain/model"
+	"server/internal/storage"
+)
+
+type StatusStorage struct {
+	db *sql.DB
+}
+
+func NewStatusStorage(db *sql.DB) *StatusStorage {
+	return &StatusStorage{db: db}
+}
+
+func (s *StatusStorage) Stop() error {
+	return s.db.Close()
+}
+
+func (s *StatusStorage) SaveStatus(ctx context.Context, userID int64, name string) (int64, error) {
+	const op = "storage.sqlite.save_status"
+
+	req, err := conn(ctx, s.db).PrepareContext(ctx, `INSERT INTO Statuses(status, status_user_id, position)
+    VALUES (?, ?, (SELECT COALESCE(MAX(position), 0) + 1 FROM Statuses WHERE status_user_id IS NULL OR status_user_id = ?))`)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	res, err := req.ExecContext(ctx, name, userID, userID)
+
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && errors.Is(sqliteErr.ExtendedCode, sqlite3.ErrConstraintUnique) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrStatusExist)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (s *StatusStorage) GetStatuses(ctx context.Context, userID int64) ([]model.Status, error) {
+	const op = "storage.sqlite.get_statuses"
+
+	var statuses []model.Status
+
+	req, err := conn(ctx, s.db).PrepareContext(ctx, `SELECT id, status, COALESCE(status_user_id, 0), position FROM Statuses
+    WHERE status_user_id IS NULL OR status_user_id = ? ORDER BY position, id`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var status model.Status
+
+		if err := rows.Scan(&status.ID, &status.Status, &status.UserID, &status.Position); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		statuses = append(statuses, status)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statuses, nil
+}
+
+func (s *StatusStorage) GetStatusByID(ctx context.Context, statusID int64, userID int64) (model.Status, error) {
+	const op = "storage.sqlite.get_status_by_id"
+
+	var status model.Status
+
+	err := conn(ctx, s.db).QueryRowContext(ctx, `SELECT id, status, COALESCE(status_user_id, 0), position FROM Statuses
+    WHERE id = ? AND (status_user_id IS NULL OR status_user_id = ?)`, statusID, userID).
+		Scan(&status.ID, &status.Status, &status.UserID, &status.Position)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Status{}, fmt.Errorf("%s: %w", op, storage.ErrStatusNotFound)
+		}
+		return model.Status{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return status, nil
+}
+
+func (s *StatusStorage) GetStatusByName(ctx context.Context, name string) (model.Status, error) {
+	const op = "storage.sqlite.get_status_by_name"
+
+	var status model.Status
+
+	err := conn(ctx, s.db).QueryRowContext(ctx, `SELECT id, status, position FROM Statuses
+    WHERE status = ? AND status_user_id IS NULL`, name).Scan(&status.ID, &status.Status, &status.Position)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Status{}, fmt.Errorf("%s: %w", op, storage.ErrStatusNotFound)
+		}
+		return model.Status{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return status, nil
+}
+
+func (s *StatusStorage) RenameStatus(ctx context.Context, statusID int64, userID int64, name string) error {
+	const op = "storage.sqlite.rename_status"
+
+	req, err := conn(ctx, s.db).PrepareContext(ctx, "UPDATE Statuses SET status = ? WHERE id = ? AND status_user_id = ?")
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	res, err := req.ExecContext(ctx, name, statusID, userID)
+
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && errors.Is(sqliteErr.ExtendedCode, sqlite3.ErrConstraintUnique) {
+			return fmt.Errorf("%s: %w", op, storage.ErrStatusExist)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingStatusError(ctx, s.db, statusID))
+	}
+
+	return nil
+}
+
+func (s *StatusStorage) ReorderStatuses(ctx context.Context, userID int64, statusIDs []int64) error {
+	const op = "storage.sqlite.reorder_statuses"
+
+	tx, err := begin(ctx, s.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	var base int64
+
+	err = tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(position), 0) FROM Statuses WHERE status_user_id IS NULL").Scan(&base)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	req, err := tx.PrepareContext(ctx, "UPDATE Statuses SET position = ? WHERE id = ? AND status_user_id = ?")
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	for i, statusID := range statusIDs {
+		res, err := req.ExecContext(ctx, base+int64(i)+1, statusID, userID)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		rows, err := res.RowsAffected()
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if rows == 0 {
+			return fmt.Errorf("%s: %w", op, missingStatusError(ctx, tx, statusID))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *StatusStorage) RemoveStatus(ctx context.Context, statusID int64, userID int64, fallbackStatusID int64) error {
+	const op = "storage.sqlite.remove_status"
+
+	tx, err := begin(ctx, s.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	// Workspace tasks of other members may carry the status too.
+	taskIDs, err := queryIDs(ctx, tx, "SELECT id FROM Tasks WHERE task_status_id = ?", statusID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = changeTasks(ctx, tx, taskIDs, statusField, userID, model.TaskStatusChanged, func() error {
+		_, err := tx.ExecContext(ctx, "UPDATE Tasks SET task_status_id = ? WHERE task_status_id = ?", fallbackStatusID, statusID)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM ProjectStatuses WHERE status_id = ?", statusID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM Statuses WHERE id = ? AND status_user_id = ?", statusID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingStatusError(ctx, tx, statusID))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// missingStatusError tells a status that does not exist apart from a shared
+// or foreign one that the caller is not allowed to modify.
+func missingStatusError(ctx context.Context, db queryRower, statusID int64) error {
+	var exists bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Statuses WHERE id = ?)", statusID).Scan(&exists)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return storage.ErrStatusAccessDenied
+	}
+
+	return storage.ErrStatusNotFound
+}
@@ -0,0 +1,202 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"sort"
+)
+
+// GetTaskVersion returns the revision of a task the user may change and the
+// times its synced fields were last written.
+func (t *TaskStorage) GetTaskVersion(ctx context.Context, taskID int64, userID int64) (model.TaskVersion, error) {
+	const op = "storage.sqlite.get_task_version"
+
+	var version model.TaskVersion
+	var fieldTimes string
+
+	err := conn(ctx, t.db).QueryRowContext(ctx, `SELECT revision, COALESCE(field_times, '{}'), created_at FROM Tasks
+    WHERE id = ? AND `+taskAccess("Tasks", true), taskID, userID, userID).Scan(&version.Revision, &fieldTimes, &version.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.TaskVersion{}, fmt.Errorf("%s: %w", op, missingTaskError(ctx, conn(ctx, t.db), taskID))
+		}
+		return model.TaskVersion{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := json.Unmarshal([]byte(fieldTimes), &version.FieldTimes); err != nil {
+		return model.TaskVersion{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
+
+// ListTaskChanges returns up to limit changes after the revision: the tasks
+// the user may see as they are now, and the ids of those gone for the user.
+func (t *TaskStorage) ListTaskChanges(ctx context.Context, userID int64, after int64, limit int) (model.TaskChanges, error) {
+	const op = "storage.sqlite.list_task_changes"
+
+	// A transaction pins the counter and the rows to one snapshot.
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	var current int64
+
+	if err := tx.QueryRowContext(ctx, "SELECT revision FROM SyncCounter").Scan(&current); err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT `+taskColumns+` FROM Tasks t
+    `+taskJoins+` WHERE t.revision > ? AND t.revision <= ? AND `+taskOwnership("t", false)+` ORDER BY t.revision LIMIT ?`,
+		after, current, userID, userID, limit)
+
+	if err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var tasks []model.Task
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			rows.Close()
+			return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err = tx.QueryContext(ctx, `SELECT task_id, revision FROM TaskTombstones tt
+    WHERE tt.revision > ? AND tt.revision <= ? AND `+taskOwnership("tt", false)+`
+    UNION ALL SELECT task_id, revision FROM TaskAccessTombstones
+    WHERE user_id = ? AND revision > ? AND revision <= ? ORDER BY revision LIMIT ?`,
+		after, current, userID, userID, userID, after, current, limit)
+
+	if err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	type tombstone struct {
+		taskID   int64
+		revision int64
+	}
+
+	var tombstones []tombstone
+
+	for rows.Next() {
+		var ts tombstone
+
+		if err := rows.Scan(&ts.taskID, &ts.revision); err != nil {
+			rows.Close()
+			return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+		}
+		tombstones = append(tombstones, ts)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// A full list may go on past its last row, so the page ends where the
+	// shorter of the full lists does and holds at most limit changes.
+	cut := current
+
+	if len(tasks) == limit {
+		cut = min(cut, tasks[limit-1].Revision)
+	}
+
+	if len(tombstones) == limit {
+		cut = min(cut, tombstones[limit-1].revision)
+	}
+
+	var revisions []int64
+	for _, task := range tasks {
+		if task.Revision <= cut {
+			revisions = append(revisions, task.Revision)
+		}
+	}
+	for _, ts := range tombstones {
+		if ts.revision <= cut {
+			revisions = append(revisions, ts.revision)
+		}
+	}
+
+	if len(revisions) > limit {
+		sort.Slice(revisions, func(i, j int) bool { return revisions[i] < revisions[j] })
+		cut = revisions[limit-1]
+	}
+
+	changes := model.TaskChanges{Revision: cut, HasMore: cut < current}
+
+	for _, task := range tasks {
+		if task.Revision <= changes.Revision {
+			changes.Tasks = append(changes.Tasks, task)
+		}
+	}
+
+	for _, ts := range tombstones {
+		if ts.revision <= changes.Revision {
+			changes.Purged = append(changes.Purged, ts.taskID)
+		}
+	}
+
+	if err := t.loadRelated(ctx, changes.Tasks); err != nil {
+		return model.TaskChanges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return changes, nil
+}
+
+// GetClientTask returns the task a sync created for the client id of the user.
+func (t *TaskStorage) GetClientTask(ctx context.Context, userID int64, clientID string) (int64, error) {
+	const op = "storage.sqlite.get_client_task"
+
+	var taskID int64
+
+	err := conn(ctx, t.db).QueryRowContext(ctx, "SELECT task_id FROM SyncClientTasks WHERE user_id = ? AND client_id = ?",
+		userID, clientID).Scan(&taskID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrClientTaskNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return taskID, nil
+}
+
+// SaveClientTask remembers the task a sync created for the client id of the user.
+func (t *TaskStorage) SaveClientTask(ctx context.Context, userID int64, clientID string, taskID int64) error {
+	const op = "storage.sqlite.save_client_task"
+
+	_, err := conn(ctx, t.db).ExecContext(ctx, "INSERT INTO SyncClientTasks(user_id, client_id, task_id) VALUES (?, ?, ?)",
+		userID, clientID, taskID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -3,23 +3,77 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"server/internal/domain/model"
 	"server/internal/storage"
+	"strings"
+	"time"
 )
 
 type TaskStorage struct {
 	db *sql.DB
 }
 
-func NewTaskStorage(storagePath string) (*TaskStorage, error) {
-	const op = "storage.sqlite.new"
-	db, err := sql.Open("sqlite3", storagePath)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+const (
+	taskColumns = `t.id, t.title, COALESCE(t.body, ''), t.created_at, t.due_at, t.remind_at, t.priority, COALESCE(t.parent_id, 0), COALESCE(t.project_id, 0), COALESCE(t.workspace_id, 0),
+    COALESCE(t.recurrence, ''), COALESCE(t.recurrence_tz, ''), t.recurrence_start, t.deleted_at, t.revision, u.id, u.name, u.login,
+    COALESCE(c.id, 0), COALESCE(c.name, ''), COALESCE(c.login, ''), s.id, s.status,
+    (SELECT COUNT(*) FROM Comments cm WHERE cm.task_id = t.id)`
+
+	taskJoins = `INNER JOIN Users u ON u.id = t.task_user_id 
+    LEFT JOIN Users c ON c.id = t.creator_id
+    INNER JOIN Statuses s ON t.task_status_id = s.id`
+)
+
+// taskAccess returns a condition, binding the user id twice, that holds when
+// the user may see the task outside the trash or, with write, change it.
+func taskAccess(table string, write bool) string {
+	return fmt.Sprintf(`%s.deleted_at IS NULL AND %s`, table, taskOwnership(table, write))
+}
+
+// trashAccess is taskAccess for the tasks in the trash; only those who may
+// change a task may restore or purge it.
+func trashAccess(table string) string {
+	return fmt.Sprintf(`%s.deleted_at IS NOT NULL AND %s`, table, taskOwnership(table, true))
+}
+
+func taskOwnership(table string, write bool) string {
+	roles := ""
+	if write {
+		roles = " AND role IN ('owner', 'admin', 'member')"
 	}
-	return &TaskStorage{db: db}, nil
+
+	return fmt.Sprintf(`(%[1]s.workspace_id IS NULL AND %[1]s.task_user_id = ? OR %[1]s.workspace_id IN (
+    SELECT workspace_id FROM WorkspaceMembers WHERE member_user_id = ?%[2]s))`, table, roles)
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTask reads a row selected with taskColumns; extra destinations are
+// filled from the columns that follow.
+func scanTask(row rowScanner, extra ...interface{}) (model.Task, error) {
+	var task model.Task
+
+	dest := []interface{}{
+		&task.ID, &task.Title, &task.Body, &task.CreatedAt, &task.DueAt, &task.RemindAt, &task.Priority, &task.ParentID, &task.ProjectID, &task.WorkspaceID,
+		&task.Recurrence.Rule, &task.Recurrence.Timezone, &task.Recurrence.Start, &task.DeletedAt, &task.Revision,
+		&task.User.ID, &task.User.Name, &task.User.Login,
+		&task.Creator.ID, &task.Creator.Name, &task.Creator.Login,
+		&task.Status.ID, &task.Status.Status,
+		&task.CommentCount,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+
+	return task, err
+}
+
+func NewTaskStorage(db *sql.DB) *TaskStorage {
+	return &TaskStorage{db: db}
 }
 
 func (t *TaskStorage) Stop() error {
@@ -29,12 +83,16 @@ func (t *TaskStorage) Stop() error {
 func (t *TaskStorage) SaveTask(ctx context.Context, task model.RequestTask) (int64, error) {
 	const op = "storage.sqlite.save_task"
 
-	req, err := t.db.Prepare("INSERT INTO Tasks(title, body, created_at, task_user_id, task_status_id) VALUES (?, ?, ?, ?, ?)")
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `INSERT INTO Tasks(title, body, created_at, task_user_id, creator_id, task_status_id, due_at, remind_at, priority, parent_id, project_id, workspace_id, recurrence, recurrence_tz, recurrence_start)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
 	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	res, err := req.ExecContext(ctx, task.Title, task.Body, task.CreatedAt, task.UserID, task.StatusID)
+	defer req.Close()
+
+	res, err := req.ExecContext(ctx, task.Title, task.Body, task.CreatedAt, task.UserID, task.CreatorID, task.StatusID, utcOrNil(task.DueAt), utcOrNil(task.RemindAt), task.Priority, idOrNil(task.ParentID), idOrNil(task.ProjectID), idOrNil(task.WorkspaceID),
+		textOrNil(task.Recurrence.Rule), textOrNil(task.Recurrence.Timezone), utcOrNil(task.Recurrence.Start))
 
 	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
@@ -49,57 +107,145 @@ func (t *TaskStorage) SaveTask(ctx context.Context, task model.RequestTask) (int
 	return id, nil
 }
 
-func (t *TaskStorage) GetTaskByID(ctx context.Context, taskID int64) (model.Task, error) {
+func (t *TaskStorage) GetTaskByID(ctx context.Context, taskID int64, userID int64) (model.Task, error) {
 	const op = "storage.sqlite.get_task_by_id"
 
-	var task model.Task
-	var user model.TodosUser
-	var status model.Status
-
-	req, err := t.db.Prepare(`SELECT t.id, t.title, t.body, t.created_at, u.id, u.name, u.login, s.id, s.status FROM Tasks t 
-    INNER JOIN Users u ON u.id = t.task_user_id 
-    INNER JOIN Statuses s ON t.task_status_id = s.id WHERE t.id = ?`)
-
-	defer req.Close()
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `SELECT `+taskColumns+` FROM Tasks t 
+    `+taskJoins+` WHERE t.id = ? AND `+taskAccess("t", false))
 
 	if err != nil {
-		return task, fmt.Errorf("%s: %w", op, err)
+		return model.Task{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	row := req.QueryRowContext(ctx, taskID)
+	defer req.Close()
 
-	err = row.Scan(&task.ID, &task.Title, &task.Body, &task.CreatedAt, &user.ID, &user.Name, &user.Login, &status.ID, &status.Status)
+	task, err := scanTask(req.QueryRowContext(ctx, taskID, userID, userID))
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return model.Task{}, fmt.Errorf("%s: %w", op, storage.ErrTaskNotFound)
+			return model.Task{}, fmt.Errorf("%s: %w", op, missingTaskError(ctx, conn(ctx, t.db), taskID))
 		}
 		return model.Task{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	task.User = user
+	tasks := []model.Task{task}
 
-	task.Status = status
+	if err := t.loadRelated(ctx, tasks); err != nil {
+		return model.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
 
-	return task, nil
+	return tasks[0], nil
 }
 
-func (t *TaskStorage) GetAllUserTasks(ctx context.Context, userID int64) ([]model.Task, error) {
-	const op = "storage.sqlite.all_user_tasks"
+func (t *TaskStorage) ListUserTasks(ctx context.Context, filter model.TaskFilter) ([]model.Task, error) {
+	const op = "storage.sqlite.list_user_tasks"
 
 	var tasks []model.Task
 
-	req, err := t.db.Prepare(`SELECT t.id, t.title, t.body, t.created_at, u.id, u.name, u.login, s.id, s.status FROM Tasks t 
-    INNER JOIN Users u ON u.id = t.task_user_id 
-    INNER JOIN Statuses s ON t.task_status_id = s.id WHERE t.task_user_id = ?`)
+	var filters string
+	var args []interface{}
 
-	defer req.Close()
+	if filter.WorkspaceID != 0 {
+		filters += " AND t.workspace_id = ?"
+		args = append(args, filter.WorkspaceID)
+	}
+
+	if filter.AssignedToMe {
+		filters += " AND EXISTS (SELECT 1 FROM TaskAssignees ta WHERE ta.task_id = t.id AND ta.user_id = ?)"
+		args = append(args, filter.UserID)
+	}
+
+	if filter.ProjectID != 0 {
+		filters += " AND t.project_id = ?"
+		args = append(args, filter.ProjectID)
+	}
+
+	if len(filter.StatusIDs) > 0 {
+		filters += " AND t.task_status_id IN (?" + strings.Repeat(", ?", len(filter.StatusIDs)-1) + ")"
+		for _, statusID := range filter.StatusIDs {
+			args = append(args, statusID)
+		}
+	}
+
+	if len(filter.LabelIDs) > 0 {
+		filters += " AND EXISTS (SELECT 1 FROM TaskLabels tl WHERE tl.task_id = t.id AND tl.label_id IN (?" + strings.Repeat(", ?", len(filter.LabelIDs)-1) + "))"
+		for _, labelID := range filter.LabelIDs {
+			args = append(args, labelID)
+		}
+	}
+
+	if len(filter.Priorities) > 0 {
+		filters += " AND t.priority IN (?" + strings.Repeat(", ?", len(filter.Priorities)-1) + ")"
+		for _, priority := range filter.Priorities {
+			args = append(args, priority)
+		}
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		filters += " AND t.created_at >= ?"
+		args = append(args, filter.CreatedAfter.UTC())
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		filters += " AND t.created_at < ?"
+		args = append(args, filter.CreatedBefore.UTC())
+	}
+
+	// position is where the column sits in taskColumns.
+	column, position, direction := "t.created_at", 4, "DESC"
+
+	switch filter.Sort {
+	case model.TaskSortCreatedAtAsc:
+		direction = "ASC"
+	case model.TaskSortTitleAsc:
+		column, position, direction = "t.title", 2, "ASC"
+	case model.TaskSortTitleDesc:
+		column, position, direction = "t.title", 2, "DESC"
+	}
+
+	if filter.After != nil {
+		comparison := "<"
+		if direction == "ASC" {
+			comparison = ">"
+		}
+
+		var key interface{} = filter.After.CreatedAt.UTC()
+		if column == "t.title" {
+			key = filter.After.Title
+		}
+
+		filters += fmt.Sprintf(" AND (%[1]s %[2]s ? OR (%[1]s = ? AND t.id %[2]s ?))", column, comparison)
+		args = append(args, key, key, filter.After.ID)
+	}
+
+	// The personal and the workspace tasks are read apart, each along the keyset
+	// index of its own, and merged; a single OR over the two can use neither.
+	// The unary plus keeps the planner off the index on deleted_at.
+	branch := func(access string) string {
+		return `SELECT * FROM (SELECT ` + taskColumns + ` FROM Tasks t
+    ` + taskJoins + ` WHERE +t.deleted_at IS NULL AND ` + access + filters +
+			fmt.Sprintf(" ORDER BY %[1]s %[2]s, t.id %[2]s LIMIT ?)", column, direction)
+	}
+
+	query := branch("t.workspace_id IS NULL AND t.task_user_id = ?") + `
+    UNION ALL ` + branch("t.workspace_id IN (SELECT workspace_id FROM WorkspaceMembers WHERE member_user_id = ?)") +
+		fmt.Sprintf(" ORDER BY %[1]d %[2]s, 1 %[2]s LIMIT ?", position, direction)
+
+	branchArgs := args
+	args = append([]interface{}{filter.UserID}, branchArgs...)
+	args = append(args, filter.Limit, filter.UserID)
+	args = append(args, branchArgs...)
+	args = append(args, filter.Limit, filter.Limit)
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, query)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	rows, err := req.QueryContext(ctx, userID)
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, args...)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -107,16 +253,10 @@ func (t *TaskStorage) GetAllUserTasks(ctx context.Context, userID int64) ([]mode
 	defer rows.Close()
 
 	for rows.Next() {
-		var task model.Task
-		var user model.TodosUser
-		var status model.Status
-
-		err = rows.Scan(&task.ID, &task.Title, &task.Body, &task.CreatedAt, &user.ID, &user.Name, &user.Login, &status.ID, &status.Status)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
-		task.User = user
-		task.Status = status
 		tasks = append(tasks, task)
 	}
 
@@ -124,19 +264,249 @@ func (t *TaskStorage) GetAllUserTasks(ctx context.Context, userID int64) ([]mode
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := t.loadRelated(ctx, tasks); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return tasks, nil
 
 }
 
-func (t *TaskStorage) Remove(ctx context.Context, taskID int64) error {
+// subtreeCTE selects the given task and all of its descendants.
+const subtreeCTE = `WITH RECURSIVE subtree(id) AS (
+    SELECT ? UNION ALL SELECT t.id FROM Tasks t INNER JOIN subtree ON t.parent_id = subtree.id) `
+
+// Remove moves the task into the trash. With cascade its whole subtree goes
+// with it, otherwise the direct children are moved up to the task's own parent.
+// Everything attached to the tasks stays until they are purged.
+func (t *TaskStorage) Remove(ctx context.Context, taskID int64, userID int64, cascade bool, deletedAt time.Time) error {
 	const op = "storage.sqlite.remove_task"
-	req, err := t.db.Prepare("DELETE FROM Tasks WHERE id = ?")
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	var parentID sql.NullInt64
+
+	err = tx.QueryRowContext(ctx, "SELECT parent_id FROM Tasks WHERE id = ? AND "+taskAccess("Tasks", true), taskID, userID, userID).Scan(&parentID)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, missingTaskError(ctx, tx, taskID))
+		}
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	res, err := req.ExecContext(ctx, taskID)
+	if !cascade {
+		_, err = tx.ExecContext(ctx, "UPDATE Tasks SET parent_id = ? WHERE parent_id = ? AND deleted_at IS NULL", parentID, taskID)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// The whole subtree shares one deletion time, which is how RestoreTask
+	// tells it apart from descendants trashed on their own before.
+	if cascade {
+		_, err = tx.ExecContext(ctx, subtreeCTE+"UPDATE Tasks SET deleted_at = ? WHERE id IN (SELECT id FROM subtree) AND deleted_at IS NULL",
+			taskID, deletedAt.UTC())
+	} else {
+		_, err = tx.ExecContext(ctx, "UPDATE Tasks SET deleted_at = ? WHERE id = ?", deletedAt.UTC(), taskID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (t *TaskStorage) GetSubtasks(ctx context.Context, taskID int64, userID int64) ([]model.Task, error) {
+	const op = "storage.sqlite.get_subtasks"
+
+	var tasks []model.Task
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `SELECT `+taskColumns+` FROM Tasks t 
+    `+taskJoins+` WHERE t.parent_id = ? AND `+taskAccess("t", false)+` ORDER BY t.created_at, t.id`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, taskID, userID, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.loadRelated(ctx, tasks); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+func (t *TaskStorage) UpdateTask(ctx context.Context, taskID int64, userID int64, task model.UpdateTask) error {
+	const op = "storage.sqlite.update_task"
+
+	var columns []string
+	var args []interface{}
+
+	if task.Title != nil {
+		columns = append(columns, "title = ?")
+		args = append(args, *task.Title)
+	}
+
+	if task.Body != nil {
+		columns = append(columns, "body = ?")
+		args = append(args, *task.Body)
+	}
+
+	if task.DueAt != nil {
+		columns = append(columns, "due_at = ?")
+		args = append(args, utcOrNil(task.DueAt))
+	}
+
+	if task.RemindAt != nil {
+		columns = append(columns, "remind_at = ?", "reminded_at = NULL", "remind_attempts = 0", "remind_retry_at = NULL")
+		args = append(args, utcOrNil(task.RemindAt))
+	}
+
+	if task.Priority != nil {
+		columns = append(columns, "priority = ?")
+		args = append(args, *task.Priority)
+	}
+
+	if task.Recurrence != nil {
+		columns = append(columns, "recurrence = ?", "recurrence_tz = ?", "recurrence_start = ?")
+		args = append(args, textOrNil(task.Recurrence.Rule), textOrNil(task.Recurrence.Timezone), utcOrNil(task.Recurrence.Start))
+	}
+
+	if task.StatusID != nil {
+		var exists bool
+
+		err := conn(ctx, t.db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Statuses WHERE id = ? AND (status_user_id IS NULL OR status_user_id = ?))",
+			*task.StatusID, userID).Scan(&exists)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if !exists {
+			return fmt.Errorf("%s: %w", op, storage.ErrStatusNotFound)
+		}
+
+		columns = append(columns, "task_status_id = ?")
+		args = append(args, *task.StatusID)
+	}
+
+	if len(columns) == 0 {
+		return fmt.Errorf("%s: nothing to update", op)
+	}
+
+	// field_times is merged here rather than in SQL, the driver is built
+	// without the JSON functions; the transaction keeps concurrent writes
+	// from dropping each other's times.
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if stamps := fieldStamps(task); !task.UpdatedAt.IsZero() && len(stamps) > 0 {
+		var raw sql.NullString
+
+		err := tx.QueryRowContext(ctx, "SELECT field_times FROM Tasks WHERE id = ?", taskID).Scan(&raw)
+
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		fieldTimes := map[string]time.Time{}
+
+		if raw.Valid {
+			if err := json.Unmarshal([]byte(raw.String), &fieldTimes); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		for _, field := range stamps {
+			fieldTimes[field] = task.UpdatedAt.UTC()
+		}
+
+		merged, err := json.Marshal(fieldTimes)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		columns = append(columns, "field_times = ?")
+		args = append(args, string(merged))
+	}
+
+	args = append(args, taskID, userID, userID)
+
+	res, err := tx.ExecContext(ctx, "UPDATE Tasks SET "+strings.Join(columns, ", ")+" WHERE id = ? AND "+taskAccess("Tasks", true), args...)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, missingTaskError(ctx, conn(ctx, t.db), taskID))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// MoveTask puts the task into the project; a zero projectID takes it out of any project.
+func (t *TaskStorage) MoveTask(ctx context.Context, taskID int64, userID int64, projectID int64) error {
+	const op = "storage.sqlite.move_task"
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, "UPDATE Tasks SET project_id = ? WHERE id = ? AND "+taskAccess("Tasks", true))
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	res, err := req.ExecContext(ctx, idOrNil(projectID), taskID, userID, userID)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -149,8 +519,261 @@ func (t *TaskStorage) Remove(ctx context.Context, taskID int64) error {
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("%s: no rows affected", op)
+		return fmt.Errorf("%s: %w", op, missingTaskError(ctx, conn(ctx, t.db), taskID))
 	}
 
 	return nil
 }
+
+// fieldStamps names the synced fields the update writes, as they are keyed
+// in field_times.
+func fieldStamps(task model.UpdateTask) []string {
+	var fields []string
+
+	if task.Title != nil {
+		fields = append(fields, "title")
+	}
+
+	if task.Body != nil {
+		fields = append(fields, "body")
+	}
+
+	if task.StatusID != nil {
+		fields = append(fields, "status")
+	}
+
+	if task.Priority != nil {
+		fields = append(fields, "priority")
+	}
+
+	if task.DueAt != nil {
+		fields = append(fields, "due_at")
+	}
+
+	if task.RemindAt != nil {
+		fields = append(fields, "remind_at")
+	}
+
+	return fields
+}
+
+// missingTaskError explains why a query scoped by taskAccess matched nothing:
+// either the task does not exist at all (a trashed one counts as gone) or the
+// user may not reach it.
+func missingTaskError(ctx context.Context, db queryRower, taskID int64) error {
+	var exists bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Tasks WHERE id = ? AND deleted_at IS NULL)", taskID).Scan(&exists)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return storage.ErrTaskAccessDenied
+	}
+
+	return storage.ErrTaskNotFound
+}
+
+// textOrNil maps an empty optional string to NULL.
+func textOrNil(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+// utcOrNil stores optional timestamps in UTC and maps nil or the zero time to NULL.
+func utcOrNil(at *time.Time) interface{} {
+	if at == nil || at.IsZero() {
+		return nil
+	}
+
+	return at.UTC()
+}
+
+// idOrNil maps the zero id of an optional reference to NULL.
+func idOrNil(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+
+	return id
+}
+
+func (t *TaskStorage) ListOverdueTasks(ctx context.Context, userID int64, now time.Time, closedStatuses []string, limit int) ([]model.Task, error) {
+	const op = "storage.sqlite.list_overdue_tasks"
+
+	var tasks []model.Task
+
+	query := `SELECT ` + taskColumns + ` FROM Tasks t 
+    ` + taskJoins + ` WHERE ` + taskAccess("t", false) + ` AND t.due_at IS NOT NULL AND t.due_at < ?`
+
+	args := []interface{}{userID, userID, now.UTC()}
+
+	if len(closedStatuses) > 0 {
+		query += " AND s.status NOT IN (?" + strings.Repeat(", ?", len(closedStatuses)-1) + ")"
+		for _, status := range closedStatuses {
+			args = append(args, status)
+		}
+	}
+
+	query += " ORDER BY t.due_at, t.id LIMIT ?"
+	args = append(args, limit)
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, query)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.loadRelated(ctx, tasks); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+// ListDueTasks returns the tasks the user can see that have a due date,
+// earliest first.
+func (t *TaskStorage) ListDueTasks(ctx context.Context, userID int64, limit int) ([]model.Task, error) {
+	const op = "storage.sqlite.list_due_tasks"
+
+	var tasks []model.Task
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `SELECT `+taskColumns+` FROM Tasks t
+    `+taskJoins+` WHERE `+taskAccess("t", false)+` AND t.due_at IS NOT NULL ORDER BY t.due_at, t.id LIMIT ?`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, userID, userID, limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+// loadRelated fills the labels and assignees of the given tasks.
+func (t *TaskStorage) loadRelated(ctx context.Context, tasks []model.Task) error {
+	if err := t.loadLabels(ctx, tasks); err != nil {
+		return err
+	}
+
+	return t.loadAssignees(ctx, tasks)
+}
+
+// loadLabels fills Labels for the given tasks with a single query.
+func (t *TaskStorage) loadLabels(ctx context.Context, tasks []model.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]int, len(tasks))
+	args := make([]interface{}, 0, len(tasks))
+
+	for i, task := range tasks {
+		index[task.ID] = i
+		args = append(args, task.ID)
+	}
+
+	rows, err := conn(ctx, t.db).QueryContext(ctx, `SELECT tl.task_id, l.id, l.name, l.color, l.label_user_id FROM TaskLabels tl
+    INNER JOIN Labels l ON l.id = tl.label_id WHERE tl.task_id IN (?`+strings.Repeat(", ?", len(args)-1)+`) ORDER BY l.name`, args...)
+
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID int64
+		var label model.Label
+
+		if err := rows.Scan(&taskID, &label.ID, &label.Name, &label.Color, &label.UserID); err != nil {
+			return err
+		}
+
+		i := index[taskID]
+		tasks[i].Labels = append(tasks[i].Labels, label)
+	}
+
+	return rows.Err()
+}
+
+// loadAssignees fills Assignees for the given tasks with a single query.
+func (t *TaskStorage) loadAssignees(ctx context.Context, tasks []model.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]int, len(tasks))
+	args := make([]interface{}, 0, len(tasks))
+
+	for i, task := range tasks {
+		index[task.ID] = i
+		args = append(args, task.ID)
+	}
+
+	rows, err := conn(ctx, t.db).QueryContext(ctx, `SELECT ta.task_id, u.id, u.name, u.login FROM TaskAssignees ta
+    INNER JOIN Users u ON u.id = ta.user_id WHERE ta.task_id IN (?`+strings.Repeat(", ?", len(args)-1)+`) ORDER BY u.name, u.id`, args...)
+
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID int64
+		var user model.TodosUser
+
+		if err := rows.Scan(&taskID, &user.ID, &user.Name, &user.Login); err != nil {
+			return err
+		}
+
+		i := index[taskID]
+		tasks[i].Assignees = append(tasks[i].Assignees, user)
+	}
+
+	return rows.Err()
+}
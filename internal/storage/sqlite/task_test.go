@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"testing"
+	"time"
+)
+
+func TestTaskOwnership(t *testing.T) {
+	ctx := context.Background()
+	path := newTestDB(t)
+
+	db, err := Open(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	users := NewUserStorage(db)
+	tasks := NewTaskStorage(db)
+	statuses := NewStatusStorage(db)
+
+	owner, err := users.SaveUser(ctx, "owner", []byte("hash"), "Owner")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stranger, err := users.SaveUser(ctx, "stranger", []byte("hash"), "Stranger")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := statuses.GetStatusByName(ctx, "pending")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskID, err := tasks.SaveTask(ctx, model.RequestTask{Title: "Private", CreatedAt: time.Now().UTC(), UserID: owner, CreatorID: owner, StatusID: pending.ID})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	denied := func(err error) bool {
+		return errors.Is(err, storage.ErrTaskNotFound) || errors.Is(err, storage.ErrTaskAccessDenied)
+	}
+
+	title := "Taken over"
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"get", func() error {
+			_, err := tasks.GetTaskByID(ctx, taskID, stranger)
+			return err
+		}},
+		{"update", func() error {
+			return tasks.UpdateTask(ctx, taskID, stranger, model.UpdateTask{Title: &title})
+		}},
+		{"delete", func() error {
+			return tasks.Remove(ctx, taskID, stranger, true, time.Now())
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !denied(err) {
+				t.Fatalf("got %v, want task not found or access denied", err)
+			}
+		})
+	}
+
+	t.Run("list", func(t *testing.T) {
+		list, err := tasks.ListUserTasks(ctx, model.TaskFilter{UserID: stranger})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(list) != 0 {
+			t.Fatalf("stranger lists %d tasks, want none", len(list))
+		}
+	})
+
+	t.Run("foreign status", func(t *testing.T) {
+		statusID, err := statuses.SaveStatus(ctx, stranger, "stranger's")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = tasks.UpdateTask(ctx, taskID, owner, model.UpdateTask{StatusID: &statusID})
+
+		if !errors.Is(err, storage.ErrStatusNotFound) {
+			t.Fatalf("got %v, want status not found", err)
+		}
+	})
+
+	task, err := tasks.GetTaskByID(ctx, taskID, owner)
+
+	if err != nil {
+		t.Fatalf("owner lost the task: %v", err)
+	}
+
+	if task.Title != "Private" {
+		t.Fatalf("title = %q, the stranger's update went through", task.Title)
+	}
+}
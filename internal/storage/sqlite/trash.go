@@ -0,0 +1,251 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"server/internal/domain/model"
+	"server/internal/storage"
+	"time"
+)
+
+// ListTrash returns the trashed tasks the user may restore, most recently
+// deleted first.
+func (t *TaskStorage) ListTrash(ctx context.Context, userID int64, limit int) ([]model.Task, error) {
+	const op = "storage.sqlite.list_trash"
+
+	var tasks []model.Task
+
+	req, err := conn(ctx, t.db).PrepareContext(ctx, `SELECT `+taskColumns+` FROM Tasks t
+    `+taskJoins+` WHERE `+trashAccess("t")+` ORDER BY t.deleted_at DESC, t.id DESC LIMIT ?`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, userID, userID, limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.loadRelated(ctx, tasks); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+// RestoreTask takes the task out of the trash together with the subtasks that
+// were trashed along with it. A task whose parent is still in the trash
+// becomes a top-level task.
+func (t *TaskStorage) RestoreTask(ctx context.Context, taskID int64, userID int64) error {
+	const op = "storage.sqlite.restore_task"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTrashed(ctx, tx, taskID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `WITH RECURSIVE restored(id) AS (
+    SELECT ? UNION ALL SELECT t.id FROM Tasks t INNER JOIN restored ON t.parent_id = restored.id
+    WHERE t.deleted_at = (SELECT deleted_at FROM Tasks WHERE id = ?))
+    UPDATE Tasks SET deleted_at = NULL WHERE id IN (SELECT id FROM restored)`, taskID, taskID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE Tasks SET parent_id = NULL
+    WHERE id = ? AND parent_id IN (SELECT id FROM Tasks WHERE deleted_at IS NOT NULL)`, taskID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PurgeTask deletes a trashed task and its subtree for good. It returns the
+// blob keys of the deleted attachments, so the caller can drop the files.
+func (t *TaskStorage) PurgeTask(ctx context.Context, taskID int64, userID int64) ([]string, error) {
+	const op = "storage.sqlite.purge_task"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := checkTrashed(ctx, tx, taskID, userID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	blobKeys, err := purgeSubtree(ctx, tx, taskID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return blobKeys, nil
+}
+
+// ExpiredTrash returns up to limit tasks of every user that went into the
+// trash before the given time, oldest first.
+func (t *TaskStorage) ExpiredTrash(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	const op = "storage.sqlite.expired_trash"
+
+	// Only the roots are picked: a task whose parent is purged as well goes
+	// with the parent's subtree and must not count as an entry of its own.
+	taskIDs, err := queryIDs(ctx, conn(ctx, t.db), `SELECT id FROM Tasks WHERE deleted_at IS NOT NULL AND deleted_at < ?
+    AND (parent_id IS NULL OR parent_id NOT IN (SELECT id FROM Tasks WHERE deleted_at IS NOT NULL AND deleted_at < ?))
+    ORDER BY deleted_at, id LIMIT ?`, before.UTC(), before.UTC(), limit)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return taskIDs, nil
+}
+
+// PurgeExpiredTask deletes a task ExpiredTrash returned, whoever owns it, and
+// returns the blob keys of the deleted attachments.
+func (t *TaskStorage) PurgeExpiredTask(ctx context.Context, taskID int64) ([]string, error) {
+	const op = "storage.sqlite.purge_expired_task"
+
+	tx, err := begin(ctx, t.db)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	blobKeys, err := purgeSubtree(ctx, tx, taskID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return blobKeys, nil
+}
+
+// purgeSubtree hard-deletes the task, its descendants and everything attached
+// to them, returning the blob keys of their attachments.
+func purgeSubtree(ctx context.Context, tx dbtx, taskID int64) ([]string, error) {
+	return purgeTasks(ctx, tx, "SELECT ?", taskID)
+}
+
+// purgeTasks deletes the tasks roots selects, which binds arg once, along
+// with their subtrees and everything attached to them. It returns the blob
+// keys of the deleted attachments.
+func purgeTasks(ctx context.Context, tx dbtx, roots string, arg int64) ([]string, error) {
+	cte := `WITH RECURSIVE doomed(id) AS (` + roots + `
+    UNION SELECT t.id FROM Tasks t INNER JOIN doomed ON t.parent_id = doomed.id) `
+
+	rows, err := tx.QueryContext(ctx, cte+"SELECT blob_key FROM Attachments WHERE task_id IN (SELECT id FROM doomed)", arg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var blobKeys []string
+
+	for rows.Next() {
+		var key string
+
+		if err := rows.Scan(&key); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		blobKeys = append(blobKeys, key)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, table := range []string{"ChecklistItems", "TaskLabels", "TaskAssignees", "Comments", "Attachments"} {
+		if _, err := tx.ExecContext(ctx, cte+"DELETE FROM "+table+" WHERE task_id IN (SELECT id FROM doomed)", arg); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, cte+"DELETE FROM Tasks WHERE id IN (SELECT id FROM doomed)", arg); err != nil {
+		return nil, err
+	}
+
+	return blobKeys, nil
+}
+
+// checkTrashed makes sure the task is in the trash and the user may restore
+// or purge it.
+func checkTrashed(ctx context.Context, db queryRower, taskID int64, userID int64) error {
+	var exists bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Tasks WHERE id = ? AND "+trashAccess("Tasks")+")", taskID, userID, userID).Scan(&exists)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	var deletedAt sql.NullTime
+
+	err = db.QueryRowContext(ctx, "SELECT deleted_at FROM Tasks WHERE id = ?", taskID).Scan(&deletedAt)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ErrTaskNotFound
+		}
+		return err
+	}
+
+	if !deletedAt.Valid {
+		return storage.ErrTaskNotInTrash
+	}
+
+	return storage.ErrTaskAccessDenied
+}
@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type txKey struct{}
+
+// dbtx is what the queries need, offered by *sql.DB and *sql.Tx alike.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// sharedTx is the transaction Atomically puts into the context.
+type sharedTx struct {
+	tx         *sql.Tx
+	savepoints int
+}
+
+// conn returns the transaction the context carries, or db outside of one.
+func conn(ctx context.Context, db *sql.DB) dbtx {
+	if shared, ok := ctx.Value(txKey{}).(*sharedTx); ok {
+		return shared.tx
+	}
+
+	return db
+}
+
+// txn is the transaction of a single storage call. Inside Atomically the call
+// joins the shared transaction and leaves committing it to Atomically.
+type txn struct {
+	*sql.Tx
+	joined bool
+}
+
+func begin(ctx context.Context, db *sql.DB) (*txn, error) {
+	if shared, ok := ctx.Value(txKey{}).(*sharedTx); ok {
+		return &txn{Tx: shared.tx, joined: true}, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &txn{Tx: tx}, nil
+}
+
+func (t *txn) Commit() error {
+	if t.joined {
+		return nil
+	}
+
+	return t.Tx.Commit()
+}
+
+func (t *txn) Rollback() error {
+	if t.joined {
+		return nil
+	}
+
+	return t.Tx.Rollback()
+}
+
+// Atomically runs fn in one transaction shared by the storage calls made with
+// its context; nested calls run in a savepoint.
+func (t *TaskStorage) Atomically(ctx context.Context, fn func(ctx context.Context) error) error {
+	const op = "storage.sqlite.atomically"
+
+	if shared, ok := ctx.Value(txKey{}).(*sharedTx); ok {
+		shared.savepoints++
+		savepoint := fmt.Sprintf("sp%d", shared.savepoints)
+
+		if _, err := shared.tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := fn(ctx); err != nil {
+			if _, rbErr := shared.tx.ExecContext(ctx, "ROLLBACK TO "+savepoint); rbErr != nil {
+				return fmt.Errorf("%s: %w", op, rbErr)
+			}
+
+			if _, rbErr := shared.tx.ExecContext(ctx, "RELEASE "+savepoint); rbErr != nil {
+				return fmt.Errorf("%s: %w", op, rbErr)
+			}
+
+			return err
+		}
+
+		if _, err := shared.tx.ExecContext(ctx, "RELEASE "+savepoint); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, &sharedTx{tx: tx})); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
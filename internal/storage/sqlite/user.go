@@ -8,19 +8,16 @@ import (
 	sqlite3 "github.com/mutecomm/go-sqlcipher/v4"
 	"server/internal/domain/model"
 	"server/internal/storage"
+	"strings"
+	"time"
 )
 
 type UserStorage struct {
 	db *sql.DB
 }
 
-func NewUserStorage(storagePath string) (*UserStorage, error) {
-	const op = "storage.sqlite.new"
-	db, err := sql.Open("sqlite3", storagePath)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	return &UserStorage{db: db}, nil
+func NewUserStorage(db *sql.DB) *UserStorage {
+	return &UserStorage{db: db}
 }
 
 func (s *UserStorage) Stop() error {
@@ -30,7 +27,7 @@ func (s *UserStorage) Stop() error {
 func (s *UserStorage) SaveUser(ctx context.Context, login string, passHash []byte, name string) (int64, error) {
 	const op = "storage.sqlite.save_user"
 
-	req, err := s.db.Prepare("INSERT INTO Users(login, name, hash_password) VALUES (?, ?, ?)")
+	req, err := conn(ctx, s.db).PrepareContext(ctx, "INSERT INTO Users(login, name, hash_password) VALUES (?, ?, ?)")
 
 	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
@@ -58,7 +55,7 @@ func (s *UserStorage) SaveUser(ctx context.Context, login string, passHash []byt
 func (s *UserStorage) GetUser(ctx context.Context, login string) (model.User, error) {
 	const op = "storage.sqlite.get_user"
 
-	req, err := s.db.Prepare("SELECT id, login, name, hash_password FROM Users WHERE login = ?")
+	req, err := conn(ctx, s.db).PrepareContext(ctx, "SELECT id, login, name, hash_password FROM Users WHERE login = ? AND deleted_at IS NULL")
 	if err != nil {
 		return model.User{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -83,7 +80,7 @@ func (s *UserStorage) GetUserByID(ctx context.Context, ID int64) (model.User, er
 
 	var user model.User
 
-	req, err := s.db.Prepare("SELECT id, login, name FROM Users WHERE id = ?")
+	req, err := conn(ctx, s.db).PrepareContext(ctx, "SELECT id, login, name, hash_password FROM Users WHERE id = ? AND deleted_at IS NULL")
 
 	if err != nil {
 		return model.User{}, fmt.Errorf("%s: %w", op, err)
@@ -91,11 +88,11 @@ func (s *UserStorage) GetUserByID(ctx context.Context, ID int64) (model.User, er
 
 	row := req.QueryRowContext(ctx, ID)
 
-	err = row.Scan(&user.ID, &user.Login, &user.Name)
+	err = row.Scan(&user.ID, &user.Login, &user.Name, &user.PassHash)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return model.User{}, fmt.Errorf("%s: %w", op, err)
+			return model.User{}, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
 		}
 		return model.User{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -106,7 +103,7 @@ func (s *UserStorage) GetUserByID(ctx context.Context, ID int64) (model.User, er
 func (s *UserStorage) SaveUserSession(ctx context.Context, userID int64, refreshToken string, sessionID string, deviceID string) error {
 	const op = "storage.sqlite.save_user_session"
 
-	req, err := s.db.Prepare("INSERT INTO Sessions(id, refresh_token, session_user_id, device_id) VALUES (?, ?, ?, ?)")
+	req, err := conn(ctx, s.db).PrepareContext(ctx, "INSERT INTO Sessions(id, refresh_token, session_user_id, device_id) VALUES (?, ?, ?, ?)")
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -131,7 +128,7 @@ func (s *UserStorage) SaveUserSession(ctx context.Context, userID int64, refresh
 func (s *UserStorage) RefreshUserSession(ctx context.Context, deviceID string, userID int64, newToken string, sessionID string, oldToken string) error {
 	const op = "storage.sqlite.refresh_user_session"
 
-	req, err := s.db.Prepare("UPDATE Sessions SET refresh_token = ? WHERE device_id = ? AND session_user_id = ? AND id = ? AND refresh_token = ?")
+	req, err := conn(ctx, s.db).PrepareContext(ctx, "UPDATE Sessions SET refresh_token = ? WHERE device_id = ? AND session_user_id = ? AND id = ? AND refresh_token = ?")
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
@@ -154,7 +151,7 @@ func (s *UserStorage) RefreshUserSession(ctx context.Context, deviceID string, u
 func (s *UserStorage) RemoveUserSession(ctx context.Context, sessionID string, userID int64, deviceID string) error {
 	const op = "storage.sqlite.remove_user_session"
 
-	req, err := s.db.Prepare("DELETE FROM Sessions WHERE session_user_id = ? AND id = ?  AND device_id = ?")
+	req, err := conn(ctx, s.db).PrepareContext(ctx, "DELETE FROM Sessions WHERE session_user_id = ? AND id = ?  AND device_id = ?")
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -166,3 +163,214 @@ func (s *UserStorage) RemoveUserSession(ctx context.Context, sessionID string, u
 	}
 	return nil
 }
+
+// UpdatePassword sets the password hash of the user and ends every session of
+// the user but keepSessionID.
+func (s *UserStorage) UpdatePassword(ctx context.Context, userID int64, passHash []byte, keepSessionID string) error {
+	const op = "storage.sqlite.update_password"
+
+	tx, err := begin(ctx, s.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if err := setPassword(ctx, tx, userID, passHash, keepSessionID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SavePasswordReset stores the hash of a reset token; the tokens the user was
+// issued before stop working.
+func (s *UserStorage) SavePasswordReset(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	const op = "storage.sqlite.save_password_reset"
+
+	tx, err := begin(ctx, s.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM PasswordResets WHERE reset_user_id = ?", userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO PasswordResets(token_hash, reset_user_id, expires_at) VALUES (?, ?, ?)",
+		tokenHash, userID, expiresAt.UTC())
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SessionActive tells whether the session of the user still exists and the
+// account has not been deleted.
+func (s *UserStorage) SessionActive(ctx context.Context, userID int64, sessionID string) (bool, error) {
+	const op = "storage.sqlite.session_active"
+
+	var active bool
+
+	err := conn(ctx, s.db).QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM Sessions s INNER JOIN Users u ON u.id = s.session_user_id
+    WHERE s.id = ? AND s.session_user_id = ? AND u.deleted_at IS NULL)`, sessionID, userID).Scan(&active)
+
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return active, nil
+}
+
+// CheckPasswordReset returns the user of a password reset token that is
+// unused and not expired yet.
+func (s *UserStorage) CheckPasswordReset(ctx context.Context, tokenHash string, now time.Time) (int64, error) {
+	const op = "storage.sqlite.check_password_reset"
+
+	var userID int64
+
+	err := conn(ctx, s.db).QueryRowContext(ctx, "SELECT reset_user_id FROM PasswordResets WHERE token_hash = ? AND used_at IS NULL AND expires_at > ?",
+		tokenHash, now.UTC()).Scan(&userID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrResetTokenNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+// ResetPassword uses up the reset token, sets the password hash of its user
+// and ends all the user's sessions. A token that is unknown, used or expired
+// at now gives ErrResetTokenNotFound.
+func (s *UserStorage) ResetPassword(ctx context.Context, tokenHash string, passHash []byte, now time.Time) (int64, error) {
+	const op = "storage.sqlite.reset_password"
+
+	tx, err := begin(ctx, s.db)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	var userID int64
+
+	err = tx.QueryRowContext(ctx, "SELECT reset_user_id FROM PasswordResets WHERE token_hash = ? AND used_at IS NULL AND expires_at > ?",
+		tokenHash, now.UTC()).Scan(&userID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, storage.ErrResetTokenNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, "UPDATE PasswordResets SET used_at = ? WHERE token_hash = ? AND used_at IS NULL", now.UTC(), tokenHash)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Only one of two concurrent resets with the same token may win.
+	if rows, err := res.RowsAffected(); err != nil || rows == 0 {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrResetTokenNotFound)
+	}
+
+	if err := setPassword(ctx, tx, userID, passHash, ""); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+// setPassword writes the password hash and removes the sessions of the user
+// other than keepSessionID, or all of them when it is empty.
+func setPassword(ctx context.Context, tx dbtx, userID int64, passHash []byte, keepSessionID string) error {
+	res, err := tx.ExecContext(ctx, "UPDATE Users SET hash_password = ? WHERE id = ?", passHash, userID)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return storage.ErrUserNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM Sessions WHERE session_user_id = ? AND id <> ?", userID, keepSessionID)
+
+	return err
+}
+
+func (s *UserStorage) UpdateUser(ctx context.Context, userID int64, user model.UpdateUser) error {
+	const op = "storage.sqlite.update_user"
+
+	var (
+		sets []string
+		args []interface{}
+	)
+
+	if user.Name != nil {
+		sets = append(sets, "name = ?")
+		args = append(args, *user.Name)
+	}
+
+	if user.Login != nil {
+		sets = append(sets, "login = ?")
+		args = append(args, *user.Login)
+	}
+
+	if len(sets) == 0 {
+		return nil
+	}
+
+	args = append(args, userID)
+
+	res, err := conn(ctx, s.db).ExecContext(ctx, "UPDATE Users SET "+strings.Join(sets, ", ")+" WHERE id = ? AND deleted_at IS NULL", args...)
+
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && errors.Is(sqliteErr.ExtendedCode, sqlite3.ErrConstraintUnique) {
+			return fmt.Errorf("%s: %w", op, storage.ErrUserExist)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
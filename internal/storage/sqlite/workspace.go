@@ -0,0 +1,300 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	sqlite3 "github.com/mutecomm/go-sqlcipher/v4"
+	"server/internal/domain/model"
+	"server/internal/storage"
+)
+
+type WorkspaceStorage struct {
+	db *sql.DB
+}
+
+func NewWorkspaceStorage(db *sql.DB) *WorkspaceStorage {
+	return &WorkspaceStorage{db: db}
+}
+
+func (w *WorkspaceStorage) Stop() error {
+	return w.db.Close()
+}
+
+// SaveWorkspace stores the workspace and makes its creator the owner.
+func (w *WorkspaceStorage) SaveWorkspace(ctx context.Context, workspace model.Workspace) (int64, error) {
+	const op = "storage.sqlite.save_workspace"
+
+	tx, err := begin(ctx, w.db)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "INSERT INTO Workspaces(name, created_at, workspace_owner_id) VALUES (?, ?, ?)",
+		workspace.Name, workspace.CreatedAt, workspace.OwnerID)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO WorkspaceMembers(workspace_id, member_user_id, role) VALUES (?, ?, ?)",
+		id, workspace.OwnerID, model.RoleOwner)
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetWorkspace returns the workspace with its members and the role of the user,
+// who has to be a member.
+func (w *WorkspaceStorage) GetWorkspace(ctx context.Context, workspaceID int64, userID int64) (model.Workspace, error) {
+	const op = "storage.sqlite.get_workspace"
+
+	var workspace model.Workspace
+
+	err := conn(ctx, w.db).QueryRowContext(ctx, `SELECT w.id, w.name, w.created_at, w.workspace_owner_id, m.role FROM Workspaces w
+    INNER JOIN WorkspaceMembers m ON m.workspace_id = w.id WHERE w.id = ? AND m.member_user_id = ?`, workspaceID, userID).
+		Scan(&workspace.ID, &workspace.Name, &workspace.CreatedAt, &workspace.OwnerID, &workspace.Role)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Workspace{}, fmt.Errorf("%s: %w", op, missingWorkspaceError(ctx, w.db, workspaceID))
+		}
+		return model.Workspace{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := conn(ctx, w.db).QueryContext(ctx, `SELECT m.workspace_id, u.id, u.name, u.login, m.role FROM WorkspaceMembers m
+    INNER JOIN Users u ON u.id = m.member_user_id WHERE m.workspace_id = ? ORDER BY u.name, u.id`, workspaceID)
+
+	if err != nil {
+		return model.Workspace{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var member model.WorkspaceMember
+
+		if err := rows.Scan(&member.WorkspaceID, &member.User.ID, &member.User.Name, &member.User.Login, &member.Role); err != nil {
+			return model.Workspace{}, fmt.Errorf("%s: %w", op, err)
+		}
+		workspace.Members = append(workspace.Members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return model.Workspace{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return workspace, nil
+}
+
+// GetWorkspaces returns the workspaces the user is a member of, without members.
+func (w *WorkspaceStorage) GetWorkspaces(ctx context.Context, userID int64) ([]model.Workspace, error) {
+	const op = "storage.sqlite.get_workspaces"
+
+	var workspaces []model.Workspace
+
+	req, err := conn(ctx, w.db).PrepareContext(ctx, `SELECT w.id, w.name, w.created_at, w.workspace_owner_id, m.role FROM Workspaces w
+    INNER JOIN WorkspaceMembers m ON m.workspace_id = w.id WHERE m.member_user_id = ? ORDER BY w.name, w.id`)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer req.Close()
+
+	rows, err := req.QueryContext(ctx, userID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var workspace model.Workspace
+
+		if err := rows.Scan(&workspace.ID, &workspace.Name, &workspace.CreatedAt, &workspace.OwnerID, &workspace.Role); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		workspaces = append(workspaces, workspace)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return workspaces, nil
+}
+
+func (w *WorkspaceStorage) MemberRole(ctx context.Context, workspaceID int64, userID int64) (model.Role, error) {
+	const op = "storage.sqlite.member_role"
+
+	var role model.Role
+
+	err := conn(ctx, w.db).QueryRowContext(ctx, "SELECT role FROM WorkspaceMembers WHERE workspace_id = ? AND member_user_id = ?", workspaceID, userID).Scan(&role)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, missingWorkspaceError(ctx, w.db, workspaceID))
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return role, nil
+}
+
+func (w *WorkspaceStorage) GetMember(ctx context.Context, workspaceID int64, userID int64) (model.WorkspaceMember, error) {
+	const op = "storage.sqlite.get_member"
+
+	var member model.WorkspaceMember
+
+	err := conn(ctx, w.db).QueryRowContext(ctx, `SELECT m.workspace_id, u.id, u.name, u.login, m.role FROM WorkspaceMembers m
+    INNER JOIN Users u ON u.id = m.member_user_id WHERE m.workspace_id = ? AND m.member_user_id = ?`, workspaceID, userID).
+		Scan(&member.WorkspaceID, &member.User.ID, &member.User.Name, &member.User.Login, &member.Role)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storage.ErrMemberNotFound)
+		}
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return member, nil
+}
+
+// AddMember adds the user with the given login to the workspace.
+func (w *WorkspaceStorage) AddMember(ctx context.Context, workspaceID int64, login string, role model.Role) (model.WorkspaceMember, error) {
+	const op = "storage.sqlite.add_member"
+
+	member := model.WorkspaceMember{WorkspaceID: workspaceID, Role: role}
+
+	err := conn(ctx, w.db).QueryRowContext(ctx, "SELECT id, name, login FROM Users WHERE login = ? AND deleted_at IS NULL", login).
+		Scan(&member.User.ID, &member.User.Name, &member.User.Login)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = conn(ctx, w.db).ExecContext(ctx, "INSERT INTO WorkspaceMembers(workspace_id, member_user_id, role) VALUES (?, ?, ?)",
+		workspaceID, member.User.ID, role)
+
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && errors.Is(sqliteErr.ExtendedCode, sqlite3.ErrConstraintPrimaryKey) {
+			return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, storage.ErrMemberExist)
+		}
+		return model.WorkspaceMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return member, nil
+}
+
+// RemoveMember drops the membership along with the member's assignments
+// to the workspace tasks, which the history of the tasks puts down to actorID.
+func (w *WorkspaceStorage) RemoveMember(ctx context.Context, workspaceID int64, userID int64, actorID int64) error {
+	const op = "storage.sqlite.remove_member"
+
+	tx, err := begin(ctx, w.db)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM WorkspaceMembers WHERE workspace_id = ? AND member_user_id = ?", workspaceID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrMemberNotFound)
+	}
+
+	taskIDs, err := queryIDs(ctx, tx, "SELECT task_id FROM TaskAssignees WHERE user_id = ? AND task_id IN (SELECT id FROM Tasks WHERE workspace_id = ?)",
+		userID, workspaceID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = changeTasks(ctx, tx, taskIDs, assigneesField, actorID, model.TaskUnassigned, func() error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM TaskAssignees WHERE user_id = ? AND task_id IN (SELECT id FROM Tasks WHERE workspace_id = ?)", userID, workspaceID)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (w *WorkspaceStorage) UpdateMemberRole(ctx context.Context, workspaceID int64, userID int64, role model.Role) error {
+	const op = "storage.sqlite.update_member_role"
+
+	res, err := conn(ctx, w.db).ExecContext(ctx, "UPDATE WorkspaceMembers SET role = ? WHERE workspace_id = ? AND member_user_id = ?", role, workspaceID, userID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrMemberNotFound)
+	}
+
+	return nil
+}
+
+// missingWorkspaceError tells a workspace that does not exist apart from one
+// the user is not a member of.
+func missingWorkspaceError(ctx context.Context, db queryRower, workspaceID int64) error {
+	var exists bool
+
+	err := db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM Workspaces WHERE id = ?)", workspaceID).Scan(&exists)
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return storage.ErrWorkspaceAccessDenied
+	}
+
+	return storage.ErrWorkspaceNotFound
+}
@@ -9,7 +9,51 @@ var (
 
 	ErrSessionNotFound = errors.New("session not found")
 
+	ErrResetTokenNotFound = errors.New("password reset token not found")
+
 	ErrUserNotFound = errors.New("user not found")
 
 	ErrTaskNotFound = errors.New("task not found")
+
+	ErrTaskAccessDenied = errors.New("access to the task is denied")
+
+	ErrTaskNotInTrash = errors.New("task is not in the trash")
+
+	ErrStatusNotFound = errors.New("status not found")
+
+	ErrStatusExist = errors.New("status already exist")
+
+	ErrStatusAccessDenied = errors.New("status is not owned by user")
+
+	ErrLabelNotFound = errors.New("label not found")
+
+	ErrLabelExist = errors.New("label already exist")
+
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+
+	ErrProjectNotFound = errors.New("project not found")
+
+	ErrProjectExist = errors.New("project already exist")
+
+	ErrProjectAccessDenied = errors.New("project belongs to another user")
+
+	ErrWorkspaceNotFound = errors.New("workspace not found")
+
+	ErrWorkspaceAccessDenied = errors.New("user is not a member of the workspace")
+
+	ErrMemberExist = errors.New("member already exist")
+
+	ErrMemberNotFound = errors.New("member not found")
+
+	ErrAssigneeAccessDenied = errors.New("assignee has no access to the task")
+
+	ErrCommentNotFound = errors.New("comment not found")
+
+	ErrCommentAccessDenied = errors.New("comment belongs to another user")
+
+	ErrAttachmentNotFound = errors.New("attachment not found")
+
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+	ErrClientTaskNotFound = errors.New("no task was created for the client id")
 )
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS tasks_user_project_idx;
+
+ALTER TABLE Tasks DROP COLUMN project_id;
+
+DROP TABLE IF EXISTS ProjectStatuses;
+DROP TABLE IF EXISTS Projects;
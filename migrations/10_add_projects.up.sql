@@ -0,0 +1,26 @@
+-- Создаем таблицу проектов
+CREATE TABLE Projects
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,                     -- Автоинкрементируемый первичный ключ
+    name            TEXT      NOT NULL,                                    -- Название проекта
+    description     TEXT      NOT NULL DEFAULT '',                         -- Описание проекта
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,                   -- Дата создания проекта
+    project_user_id INTEGER   NOT NULL,                                    -- Владелец проекта
+    FOREIGN KEY (project_user_id) REFERENCES Users (id) ON DELETE CASCADE -- Внешний ключ на таблицу Users
+);
+
+CREATE UNIQUE INDEX projects_user_name_idx ON Projects (project_user_id, name);
+
+-- Набор статусов, доступных в проекте
+CREATE TABLE ProjectStatuses
+(
+    project_id INTEGER NOT NULL,
+    status_id  INTEGER NOT NULL,
+    PRIMARY KEY (project_id, status_id),
+    FOREIGN KEY (project_id) REFERENCES Projects (id) ON DELETE CASCADE,
+    FOREIGN KEY (status_id) REFERENCES Statuses (id) ON DELETE CASCADE
+);
+
+ALTER TABLE Tasks ADD COLUMN project_id INTEGER; -- Ссылка на проект (NULL - задача вне проекта)
+
+CREATE INDEX tasks_user_project_idx ON Tasks (task_user_id, project_id);
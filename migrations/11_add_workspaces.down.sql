@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS tasks_workspace_idx;
+
+ALTER TABLE Tasks DROP COLUMN workspace_id;
+
+DROP TABLE IF EXISTS WorkspaceMembers;
+DROP TABLE IF EXISTS Workspaces;
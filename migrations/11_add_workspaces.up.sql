@@ -0,0 +1,26 @@
+-- Создаем таблицу рабочих пространств
+CREATE TABLE Workspaces
+(
+    id                 INTEGER PRIMARY KEY AUTOINCREMENT,                        -- Автоинкрементируемый первичный ключ
+    name               TEXT      NOT NULL,                                       -- Название рабочего пространства
+    created_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP,                      -- Дата создания
+    workspace_owner_id INTEGER   NOT NULL,                                       -- Создатель рабочего пространства
+    FOREIGN KEY (workspace_owner_id) REFERENCES Users (id) ON DELETE CASCADE    -- Внешний ключ на таблицу Users
+);
+
+-- Участники рабочих пространств и их роли
+CREATE TABLE WorkspaceMembers
+(
+    workspace_id   INTEGER NOT NULL,                                            -- Ссылка на рабочее пространство
+    member_user_id INTEGER NOT NULL,                                            -- Ссылка на участника
+    role           TEXT    NOT NULL CHECK (role IN ('owner', 'admin', 'member', 'viewer')), -- Роль участника
+    PRIMARY KEY (workspace_id, member_user_id),
+    FOREIGN KEY (workspace_id) REFERENCES Workspaces (id) ON DELETE CASCADE,
+    FOREIGN KEY (member_user_id) REFERENCES Users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX workspace_members_user_idx ON WorkspaceMembers (member_user_id);
+
+ALTER TABLE Tasks ADD COLUMN workspace_id INTEGER; -- Ссылка на рабочее пространство (NULL - личная задача)
+
+CREATE INDEX tasks_workspace_idx ON Tasks (workspace_id);
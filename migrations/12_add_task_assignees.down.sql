@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS TaskAssignees;
+
+ALTER TABLE Tasks DROP COLUMN creator_id;
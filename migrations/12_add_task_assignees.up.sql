@@ -0,0 +1,15 @@
+ALTER TABLE Tasks ADD COLUMN creator_id INTEGER; -- Ссылка на пользователя, создавшего задачу
+
+UPDATE Tasks SET creator_id = task_user_id;
+
+-- Исполнители задач
+CREATE TABLE TaskAssignees
+(
+    task_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    PRIMARY KEY (task_id, user_id),
+    FOREIGN KEY (task_id) REFERENCES Tasks (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES Users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX task_assignees_user_idx ON TaskAssignees (user_id);
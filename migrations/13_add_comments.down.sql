@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS comments_task_created_idx;
+
+DROP TABLE IF EXISTS Comments;
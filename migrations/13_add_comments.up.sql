@@ -0,0 +1,15 @@
+-- Создаем таблицу комментариев к задачам
+CREATE TABLE Comments
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,                         -- Автоинкрементируемый первичный ключ
+    task_id    INTEGER   NOT NULL,                                        -- Ссылка на задачу
+    author_id  INTEGER   NOT NULL,                                        -- Автор комментария
+    body       TEXT      NOT NULL,                                        -- Текст комментария
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,                       -- Дата создания
+    updated_at TIMESTAMP,                                                 -- Дата последнего редактирования
+    FOREIGN KEY (task_id) REFERENCES Tasks (id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES Users (id) ON DELETE CASCADE
+);
+
+-- Комментарии задачи выводятся от старых к новым
+CREATE INDEX comments_task_created_idx ON Comments (task_id, created_at, id);
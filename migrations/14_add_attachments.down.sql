@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS attachments_task_idx;
+
+DROP TABLE IF EXISTS Attachments;
@@ -0,0 +1,17 @@
+-- Создаем таблицу вложений задач; содержимое файлов хранится в BlobStore
+CREATE TABLE Attachments
+(
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,                       -- Автоинкрементируемый первичный ключ
+    task_id      INTEGER   NOT NULL,                                      -- Ссылка на задачу
+    uploader_id  INTEGER   NOT NULL,                                      -- Пользователь, загрузивший файл
+    file_name    TEXT      NOT NULL,                                      -- Исходное имя файла
+    content_type TEXT      NOT NULL,                                      -- MIME-тип содержимого
+    size         INTEGER   NOT NULL,                                      -- Размер файла в байтах
+    checksum     TEXT      NOT NULL,                                      -- SHA-256 содержимого (hex)
+    blob_key     TEXT      NOT NULL UNIQUE,                               -- Ключ файла в BlobStore
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,                     -- Дата загрузки
+    FOREIGN KEY (task_id) REFERENCES Tasks (id) ON DELETE CASCADE,
+    FOREIGN KEY (uploader_id) REFERENCES Users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX attachments_task_idx ON Attachments (task_id, created_at, id);
@@ -0,0 +1,3 @@
+ALTER TABLE Tasks DROP COLUMN recurrence_start;
+ALTER TABLE Tasks DROP COLUMN recurrence_tz;
+ALTER TABLE Tasks DROP COLUMN recurrence;
@@ -0,0 +1,3 @@
+ALTER TABLE Tasks ADD COLUMN recurrence TEXT;             -- Правило повторения в формате iCalendar RRULE (NULL - задача не повторяется)
+ALTER TABLE Tasks ADD COLUMN recurrence_tz TEXT;          -- Часовой пояс IANA, в котором вычисляются повторения
+ALTER TABLE Tasks ADD COLUMN recurrence_start TIMESTAMP;  -- Начало серии повторений (DTSTART, UTC)
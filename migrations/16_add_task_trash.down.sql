@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS tasks_deleted_at_idx;
+
+DELETE FROM ChecklistItems WHERE task_id IN (SELECT id FROM Tasks WHERE deleted_at IS NOT NULL);
+DELETE FROM TaskLabels WHERE task_id IN (SELECT id FROM Tasks WHERE deleted_at IS NOT NULL);
+DELETE FROM TaskAssignees WHERE task_id IN (SELECT id FROM Tasks WHERE deleted_at IS NOT NULL);
+DELETE FROM Comments WHERE task_id IN (SELECT id FROM Tasks WHERE deleted_at IS NOT NULL);
+DELETE FROM Attachments WHERE task_id IN (SELECT id FROM Tasks WHERE deleted_at IS NOT NULL);
+DELETE FROM Tasks WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE Tasks DROP COLUMN deleted_at;
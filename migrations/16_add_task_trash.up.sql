@@ -0,0 +1,3 @@
+ALTER TABLE Tasks ADD COLUMN deleted_at TIMESTAMP;  -- Дата перемещения в корзину (NULL - задача не удалена)
+
+CREATE INDEX tasks_deleted_at_idx ON Tasks (deleted_at);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS task_events_no_delete;
+DROP TRIGGER IF EXISTS task_events_no_update;
+
+DROP INDEX IF EXISTS task_events_task_idx;
+
+DROP TABLE IF EXISTS TaskEvents;
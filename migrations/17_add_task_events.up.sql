@@ -0,0 +1,23 @@
+-- Создаем журнал изменений задач; записи только добавляются
+CREATE TABLE TaskEvents
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,                      -- Автоинкрементируемый первичный ключ
+    task_id    INTEGER   NOT NULL,                                     -- Ссылка на задачу
+    actor_id   INTEGER   NOT NULL,                                     -- Пользователь, совершивший действие
+    kind       TEXT      NOT NULL,                                     -- Тип события (created, updated, status_changed, deleted, ...)
+    changes    TEXT      NOT NULL DEFAULT '[]',                        -- Измененные поля: JSON-массив {field, before, after}
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP                     -- Дата события
+);
+
+CREATE INDEX task_events_task_idx ON TaskEvents (task_id, id);
+
+-- Журнал неизменяем: запрещаем правку и удаление записей
+CREATE TRIGGER task_events_no_update BEFORE UPDATE ON TaskEvents
+BEGIN
+    SELECT RAISE(ABORT, 'TaskEvents is append-only');
+END;
+
+CREATE TRIGGER task_events_no_delete BEFORE DELETE ON TaskEvents
+BEGIN
+    SELECT RAISE(ABORT, 'TaskEvents is append-only');
+END;
@@ -0,0 +1,12 @@
+DROP TRIGGER IF EXISTS tasks_revision_ad;
+DROP TRIGGER IF EXISTS tasks_revision_au;
+DROP TRIGGER IF EXISTS tasks_revision_ai;
+
+DROP INDEX IF EXISTS task_tombstones_revision_idx;
+DROP TABLE IF EXISTS TaskTombstones;
+
+DROP INDEX IF EXISTS tasks_revision_idx;
+ALTER TABLE Tasks DROP COLUMN field_times;
+ALTER TABLE Tasks DROP COLUMN revision;
+
+DROP TABLE IF EXISTS SyncCounter;
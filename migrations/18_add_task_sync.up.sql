@@ -0,0 +1,50 @@
+-- Счетчик изменений для синхронизации: каждое изменение задачи получает следующий номер
+CREATE TABLE SyncCounter
+(
+    id       INTEGER PRIMARY KEY CHECK (id = 1),                      -- Единственная строка
+    revision INTEGER NOT NULL                                         -- Последний выданный номер изменения
+);
+
+INSERT INTO SyncCounter(id, revision) VALUES (1, 0);
+
+ALTER TABLE Tasks ADD COLUMN revision INTEGER NOT NULL DEFAULT 0;  -- Номер последнего изменения задачи
+ALTER TABLE Tasks ADD COLUMN field_times TEXT;                    -- Время последней записи полей: JSON {поле: время}
+
+-- Уже существующие задачи получают номера по порядку создания
+UPDATE Tasks SET revision = id;
+UPDATE SyncCounter SET revision = (SELECT COALESCE(MAX(id), 0) FROM Tasks);
+
+CREATE INDEX tasks_revision_idx ON Tasks (revision);
+
+-- Надгробия окончательно удаленных задач, чтобы клиенты узнали об удалении
+CREATE TABLE TaskTombstones
+(
+    task_id      INTEGER PRIMARY KEY,                                 -- Идентификатор удаленной задачи
+    task_user_id INTEGER NOT NULL,                                    -- Владелец задачи
+    workspace_id INTEGER,                                             -- Рабочее пространство задачи
+    revision     INTEGER NOT NULL,                                    -- Номер изменения, удалившего задачу
+    deleted_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP                  -- Дата удаления
+);
+
+CREATE INDEX task_tombstones_revision_idx ON TaskTombstones (revision);
+
+CREATE TRIGGER tasks_revision_ai AFTER INSERT ON Tasks
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.id;
+END;
+
+CREATE TRIGGER tasks_revision_au AFTER UPDATE OF title, body, task_status_id, priority, due_at, remind_at, parent_id, project_id,
+    workspace_id, recurrence, deleted_at ON Tasks
+    WHEN new.revision = old.revision
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.id;
+END;
+
+CREATE TRIGGER tasks_revision_ad AFTER DELETE ON Tasks
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    INSERT OR REPLACE INTO TaskTombstones(task_id, task_user_id, workspace_id, revision)
+    VALUES (old.id, old.task_user_id, old.workspace_id, (SELECT revision FROM SyncCounter));
+END;
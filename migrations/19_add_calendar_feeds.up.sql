@@ -0,0 +1,8 @@
+-- Создаем таблицу календарных лент: у каждого пользователя не больше одной ссылки на ленту задач
+CREATE TABLE CalendarFeeds
+(
+    feed_user_id INTEGER PRIMARY KEY,                                     -- Владелец ленты
+    token_hash   TEXT      NOT NULL UNIQUE,                               -- SHA-256 токена ленты (hex)
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,                     -- Дата выпуска токена
+    FOREIGN KEY (feed_user_id) REFERENCES Users (id) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS password_resets_user_idx;
+
+DROP TABLE IF EXISTS PasswordResets;
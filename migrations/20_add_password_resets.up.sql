@@ -0,0 +1,12 @@
+-- Создаем таблицу токенов сброса пароля; токен одноразовый и действует ограниченное время
+CREATE TABLE PasswordResets
+(
+    token_hash    TEXT PRIMARY KEY,                                       -- SHA-256 токена сброса (hex)
+    reset_user_id INTEGER   NOT NULL,                                     -- Пользователь, сбрасывающий пароль
+    expires_at    TIMESTAMP NOT NULL,                                     -- Срок действия токена
+    used_at       TIMESTAMP,                                              -- Дата использования (NULL - не использован)
+    created_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,                    -- Дата выпуска токена
+    FOREIGN KEY (reset_user_id) REFERENCES Users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX password_resets_user_idx ON PasswordResets (reset_user_id);
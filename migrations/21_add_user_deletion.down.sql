@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS users_login_idx;
+
+ALTER TABLE Users DROP COLUMN deleted_at;
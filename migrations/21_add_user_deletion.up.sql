@@ -0,0 +1,17 @@
+-- Удаленный аккаунт обезличивается, а не удаляется: его комментарии, вложения и
+-- история в общих рабочих пространствах остаются за "удаленным пользователем"
+ALTER TABLE Users ADD COLUMN deleted_at TIMESTAMP;  -- Дата удаления аккаунта (NULL - аккаунт активен)
+
+-- Повторяющиеся логины нужно развести вручную: миграция останавливается с ошибкой
+-- "CHECK constraint failed: users_login_duplicates", пока они есть
+CREATE TEMP TABLE LoginCheck
+(
+    duplicates INTEGER CONSTRAINT users_login_duplicates CHECK (duplicates = 0) -- Число повторяющихся логинов
+);
+
+INSERT INTO LoginCheck(duplicates) SELECT COUNT(*) FROM (SELECT login FROM Users GROUP BY login HAVING COUNT(*) > 1);
+
+DROP TABLE LoginCheck;
+
+-- Логин однозначно определяет действующего пользователя
+CREATE UNIQUE INDEX users_login_idx ON Users (login) WHERE deleted_at IS NULL;
@@ -0,0 +1,11 @@
+-- Задачи, созданные синхронизацией: повтор того же изменения возвращает уже созданную задачу
+CREATE TABLE SyncClientTasks
+(
+    user_id    INTEGER NOT NULL,                                      -- Пользователь, создавший задачу
+    client_id  TEXT    NOT NULL,                                      -- Идентификатор задачи на клиенте
+    task_id    INTEGER NOT NULL,                                      -- Созданная задача
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,                   -- Дата создания
+    PRIMARY KEY (user_id, client_id),
+    FOREIGN KEY (user_id) REFERENCES Users (id) ON DELETE CASCADE,
+    FOREIGN KEY (task_id) REFERENCES Tasks (id) ON DELETE CASCADE
+);
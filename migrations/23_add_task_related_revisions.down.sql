@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS comments_revision_ad;
+DROP TRIGGER IF EXISTS comments_revision_au;
+DROP TRIGGER IF EXISTS comments_revision_ai;
+DROP TRIGGER IF EXISTS checklist_items_revision_ad;
+DROP TRIGGER IF EXISTS checklist_items_revision_au;
+DROP TRIGGER IF EXISTS checklist_items_revision_ai;
+DROP TRIGGER IF EXISTS task_assignees_revision_ad;
+DROP TRIGGER IF EXISTS task_assignees_revision_ai;
+DROP TRIGGER IF EXISTS task_labels_revision_ad;
+DROP TRIGGER IF EXISTS task_labels_revision_ai;
@@ -0,0 +1,61 @@
+-- Метки, исполнители, чек-лист и комментарии - часть задачи: их изменение дает задаче новый номер изменения
+
+CREATE TRIGGER task_labels_revision_ai AFTER INSERT ON TaskLabels
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.task_id;
+END;
+
+CREATE TRIGGER task_labels_revision_ad AFTER DELETE ON TaskLabels
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = old.task_id;
+END;
+
+CREATE TRIGGER task_assignees_revision_ai AFTER INSERT ON TaskAssignees
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.task_id;
+END;
+
+CREATE TRIGGER task_assignees_revision_ad AFTER DELETE ON TaskAssignees
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = old.task_id;
+END;
+
+CREATE TRIGGER checklist_items_revision_ai AFTER INSERT ON ChecklistItems
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.task_id;
+END;
+
+CREATE TRIGGER checklist_items_revision_au AFTER UPDATE ON ChecklistItems
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.task_id;
+END;
+
+CREATE TRIGGER checklist_items_revision_ad AFTER DELETE ON ChecklistItems
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = old.task_id;
+END;
+
+CREATE TRIGGER comments_revision_ai AFTER INSERT ON Comments
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.task_id;
+END;
+
+CREATE TRIGGER comments_revision_au AFTER UPDATE ON Comments
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.task_id;
+END;
+
+CREATE TRIGGER comments_revision_ad AFTER DELETE ON Comments
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = old.task_id;
+END;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS tasks_access_au;
+DROP TRIGGER IF EXISTS workspace_members_access_ai;
+DROP TRIGGER IF EXISTS workspace_members_access_ad;
+DROP INDEX IF EXISTS task_access_tombstones_user_revision_idx;
+DROP TABLE IF EXISTS TaskAccessTombstones;
@@ -0,0 +1,46 @@
+-- Надгробия доступа: задача перестала быть видна пользователю, хотя и не удалена,
+-- например его исключили из рабочего пространства или задачу перенесли
+CREATE TABLE TaskAccessTombstones
+(
+    task_id  INTEGER NOT NULL,                                        -- Задача, ставшая невидимой
+    user_id  INTEGER NOT NULL,                                        -- Пользователь, который ее больше не видит
+    revision INTEGER NOT NULL,                                        -- Номер изменения, скрывшего задачу
+    PRIMARY KEY (task_id, user_id)
+);
+
+CREATE INDEX task_access_tombstones_user_revision_idx ON TaskAccessTombstones (user_id, revision);
+
+-- Участник покидает пространство: все его задачи исчезают для участника
+CREATE TRIGGER workspace_members_access_ad AFTER DELETE ON WorkspaceMembers
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    INSERT OR REPLACE INTO TaskAccessTombstones(task_id, user_id, revision)
+    SELECT id, old.member_user_id, (SELECT revision FROM SyncCounter) FROM Tasks WHERE workspace_id = old.workspace_id;
+END;
+
+-- Новый участник получает задачи пространства со следующей синхронизацией
+CREATE TRIGGER workspace_members_access_ai AFTER INSERT ON WorkspaceMembers
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE workspace_id = new.workspace_id;
+    DELETE FROM TaskAccessTombstones
+    WHERE user_id = new.member_user_id AND task_id IN (SELECT id FROM Tasks WHERE workspace_id = new.workspace_id);
+END;
+
+-- Задача сменила владельца или пространство: прежние зрители, не ставшие новыми, ее теряют
+CREATE TRIGGER tasks_access_au AFTER UPDATE OF task_user_id, workspace_id ON Tasks
+BEGIN
+    UPDATE SyncCounter SET revision = revision + 1;
+    UPDATE Tasks SET revision = (SELECT revision FROM SyncCounter) WHERE id = new.id;
+    DELETE FROM TaskAccessTombstones
+    WHERE task_id = new.id AND user_id IN (
+        SELECT new.task_user_id WHERE new.workspace_id IS NULL
+        UNION SELECT member_user_id FROM WorkspaceMembers WHERE workspace_id = new.workspace_id);
+    INSERT OR REPLACE INTO TaskAccessTombstones(task_id, user_id, revision)
+    SELECT new.id, viewer.id, (SELECT revision FROM SyncCounter) FROM (
+        SELECT old.task_user_id AS id WHERE old.workspace_id IS NULL
+        UNION SELECT member_user_id FROM WorkspaceMembers WHERE workspace_id = old.workspace_id) viewer
+    WHERE viewer.id IS NOT NULL AND viewer.id NOT IN (
+        SELECT new.task_user_id WHERE new.workspace_id IS NULL AND new.task_user_id IS NOT NULL
+        UNION SELECT member_user_id FROM WorkspaceMembers WHERE workspace_id = new.workspace_id);
+END;
@@ -0,0 +1,2 @@
+ALTER TABLE Tasks DROP COLUMN remind_retry_at;
+ALTER TABLE Tasks DROP COLUMN remind_attempts;
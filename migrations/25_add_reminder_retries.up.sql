@@ -0,0 +1,3 @@
+-- Неудачная доставка напоминания откладывается, чтобы не задерживать остальные
+ALTER TABLE Tasks ADD COLUMN remind_attempts INTEGER NOT NULL DEFAULT 0; -- Число неудачных попыток доставки
+ALTER TABLE Tasks ADD COLUMN remind_retry_at TIMESTAMP;                  -- Не раньше этого времени пробовать снова
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tasks_workspace_title_idx;
+DROP INDEX IF EXISTS tasks_workspace_created_at_idx;
@@ -0,0 +1,3 @@
+-- Задачи рабочих пространств листаются по своим индексам, как личные по индексам миграции 5
+CREATE INDEX tasks_workspace_created_at_idx ON Tasks (workspace_id, created_at, id);
+CREATE INDEX tasks_workspace_title_idx ON Tasks (workspace_id, title, id);
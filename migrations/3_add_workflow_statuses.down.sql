@@ -0,0 +1,8 @@
+-- Задачи удаляемых статусов возвращаются к ближайшим оставшимся: "in progress" - к "pending", "archived" - к "complete"
+UPDATE Tasks SET task_status_id = (SELECT id FROM Statuses WHERE status = "pending")
+WHERE task_status_id IN (SELECT id FROM Statuses WHERE status = "in progress");
+
+UPDATE Tasks SET task_status_id = (SELECT id FROM Statuses WHERE status = "complete")
+WHERE task_status_id IN (SELECT id FROM Statuses WHERE status = "archived");
+
+DELETE FROM Statuses WHERE status IN ("in progress", "archived");
@@ -0,0 +1,2 @@
+INSERT INTO Statuses(status) VALUES ("in progress");
+INSERT INTO Statuses(status) VALUES ("archived");
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS statuses_user_status_idx;
+
+UPDATE Tasks
+SET task_status_id = (SELECT id FROM Statuses WHERE status = 'pending' AND status_user_id IS NULL)
+WHERE task_status_id IN (SELECT id FROM Statuses WHERE status_user_id IS NOT NULL);
+
+CREATE TABLE Statuses_old
+(
+    id     INTEGER PRIMARY KEY AUTOINCREMENT, -- Автоинкрементируемый первичный ключ
+    status TEXT NOT NULL                      -- Название статуса задачи
+);
+
+INSERT INTO Statuses_old(id, status) SELECT id, status FROM Statuses WHERE status_user_id IS NULL;
+
+DROP TABLE Statuses;
+
+ALTER TABLE Statuses_old RENAME TO Statuses;
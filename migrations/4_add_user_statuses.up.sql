@@ -0,0 +1,7 @@
+-- Пользовательские статусы: NULL в status_user_id означает общий статус
+ALTER TABLE Statuses ADD COLUMN status_user_id INTEGER REFERENCES Users (id) ON DELETE CASCADE;
+ALTER TABLE Statuses ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+UPDATE Statuses SET position = id;
+
+CREATE UNIQUE INDEX statuses_user_status_idx ON Statuses (status_user_id, status);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tasks_user_title_idx;
+DROP INDEX IF EXISTS tasks_user_created_at_idx;
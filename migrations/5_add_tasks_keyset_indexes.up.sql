@@ -0,0 +1,2 @@
+CREATE INDEX tasks_user_created_at_idx ON Tasks (task_user_id, created_at, id);
+CREATE INDEX tasks_user_title_idx ON Tasks (task_user_id, title, id);
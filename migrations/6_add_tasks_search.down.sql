@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS tasks_search_au;
+DROP TRIGGER IF EXISTS tasks_search_ad;
+DROP TRIGGER IF EXISTS tasks_search_ai;
+DROP TABLE IF EXISTS TasksSearch;
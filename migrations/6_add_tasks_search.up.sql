@@ -0,0 +1,27 @@
+-- Полнотекстовый индекс по заголовку и описанию задач (требует сборки с тегом sqlite_fts5)
+CREATE VIRTUAL TABLE TasksSearch USING fts5
+(
+    title,
+    body,
+    content = 'Tasks',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER tasks_search_ai AFTER INSERT ON Tasks
+BEGIN
+    INSERT INTO TasksSearch(rowid, title, body) VALUES (new.id, new.title, new.body);
+END;
+
+CREATE TRIGGER tasks_search_ad AFTER DELETE ON Tasks
+BEGIN
+    INSERT INTO TasksSearch(TasksSearch, rowid, title, body) VALUES ('delete', old.id, old.title, old.body);
+END;
+
+CREATE TRIGGER tasks_search_au AFTER UPDATE OF title, body ON Tasks
+BEGIN
+    INSERT INTO TasksSearch(TasksSearch, rowid, title, body) VALUES ('delete', old.id, old.title, old.body);
+    INSERT INTO TasksSearch(rowid, title, body) VALUES (new.id, new.title, new.body);
+END;
+
+INSERT INTO TasksSearch(TasksSearch) VALUES ('rebuild');
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS tasks_pending_reminders_idx;
+DROP INDEX IF EXISTS tasks_user_due_at_idx;
+
+ALTER TABLE Tasks DROP COLUMN reminded_at;
+ALTER TABLE Tasks DROP COLUMN remind_at;
+ALTER TABLE Tasks DROP COLUMN due_at;
@@ -0,0 +1,6 @@
+ALTER TABLE Tasks ADD COLUMN due_at TIMESTAMP;      -- Срок выполнения задачи (UTC)
+ALTER TABLE Tasks ADD COLUMN remind_at TIMESTAMP;   -- Время напоминания (UTC)
+ALTER TABLE Tasks ADD COLUMN reminded_at TIMESTAMP; -- Когда напоминание было отправлено
+
+CREATE INDEX tasks_user_due_at_idx ON Tasks (task_user_id, due_at);
+CREATE INDEX tasks_pending_reminders_idx ON Tasks (remind_at) WHERE reminded_at IS NULL;
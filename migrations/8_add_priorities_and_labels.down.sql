@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS TaskLabels;
+DROP TABLE IF EXISTS Labels;
+
+ALTER TABLE Tasks DROP COLUMN priority;
@@ -0,0 +1,25 @@
+ALTER TABLE Tasks ADD COLUMN priority INTEGER NOT NULL DEFAULT 0; -- Приоритет задачи (0 - не указан)
+
+-- Создаем таблицу меток
+CREATE TABLE Labels
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,                   -- Автоинкрементируемый первичный ключ
+    name          TEXT    NOT NULL,                                    -- Название метки
+    color         TEXT    NOT NULL DEFAULT '',                         -- Цвет метки
+    label_user_id INTEGER NOT NULL,                                    -- Владелец метки
+    FOREIGN KEY (label_user_id) REFERENCES Users (id) ON DELETE CASCADE -- Внешний ключ на таблицу Users
+);
+
+CREATE UNIQUE INDEX labels_user_name_idx ON Labels (label_user_id, name);
+
+-- Связь задач и меток
+CREATE TABLE TaskLabels
+(
+    task_id  INTEGER NOT NULL,
+    label_id INTEGER NOT NULL,
+    PRIMARY KEY (task_id, label_id),
+    FOREIGN KEY (task_id) REFERENCES Tasks (id) ON DELETE CASCADE,
+    FOREIGN KEY (label_id) REFERENCES Labels (id) ON DELETE CASCADE
+);
+
+CREATE INDEX task_labels_label_idx ON TaskLabels (label_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS ChecklistItems;
+
+DROP INDEX IF EXISTS tasks_parent_idx;
+
+ALTER TABLE Tasks DROP COLUMN parent_id;
@@ -0,0 +1,16 @@
+ALTER TABLE Tasks ADD COLUMN parent_id INTEGER; -- Ссылка на родительскую задачу (NULL - задача верхнего уровня)
+
+CREATE INDEX tasks_parent_idx ON Tasks (parent_id);
+
+-- Создаем таблицу пунктов чек-листа
+CREATE TABLE ChecklistItems
+(
+    id       INTEGER PRIMARY KEY AUTOINCREMENT,                   -- Автоинкрементируемый первичный ключ
+    task_id  INTEGER NOT NULL,                                    -- Ссылка на задачу
+    text     TEXT    NOT NULL,                                    -- Текст пункта
+    done     INTEGER NOT NULL DEFAULT 0,                          -- Отметка о выполнении
+    position INTEGER NOT NULL DEFAULT 0,                          -- Порядок пункта в чек-листе
+    FOREIGN KEY (task_id) REFERENCES Tasks (id) ON DELETE CASCADE -- Внешний ключ на таблицу Tasks
+);
+
+CREATE INDEX checklist_items_task_idx ON ChecklistItems (task_id, position);
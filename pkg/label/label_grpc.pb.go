@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.3
+// source: label/label.proto
+
+package label
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Label_CreateLabel_FullMethodName = "/label.Label/CreateLabel"
+	Label_ListLabels_FullMethodName  = "/label.Label/ListLabels"
+	Label_AttachLabel_FullMethodName = "/label.Label/AttachLabel"
+	Label_DetachLabel_FullMethodName = "/label.Label/DetachLabel"
+)
+
+// LabelClient is the client API for Label service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LabelClient interface {
+	CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*CreateLabelResponse, error)
+	ListLabels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLabelsResponse, error)
+	AttachLabel(ctx context.Context, in *TaskLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DetachLabel(ctx context.Context, in *TaskLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type labelClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLabelClient(cc grpc.ClientConnInterface) LabelClient {
+	return &labelClient{cc}
+}
+
+func (c *labelClient) CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*CreateLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLabelResponse)
+	err := c.cc.Invoke(ctx, Label_CreateLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelClient) ListLabels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLabelsResponse)
+	err := c.cc.Invoke(ctx, Label_ListLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelClient) AttachLabel(ctx context.Context, in *TaskLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Label_AttachLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelClient) DetachLabel(ctx context.Context, in *TaskLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Label_DetachLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LabelServer is the server API for Label service.
+// All implementations must embed UnimplementedLabelServer
+// for forward compatibility.
+type LabelServer interface {
+	CreateLabel(context.Context, *CreateLabelRequest) (*CreateLabelResponse, error)
+	ListLabels(context.Context, *emptypb.Empty) (*ListLabelsResponse, error)
+	AttachLabel(context.Context, *TaskLabelRequest) (*emptypb.Empty, error)
+	DetachLabel(context.Context, *TaskLabelRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedLabelServer()
+}
+
+// UnimplementedLabelServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLabelServer struct{}
+
+func (UnimplementedLabelServer) CreateLabel(context.Context, *CreateLabelRequest) (*CreateLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLabel not implemented")
+}
+func (UnimplementedLabelServer) ListLabels(context.Context, *emptypb.Empty) (*ListLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLabels not implemented")
+}
+func (UnimplementedLabelServer) AttachLabel(context.Context, *TaskLabelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachLabel not implemented")
+}
+func (UnimplementedLabelServer) DetachLabel(context.Context, *TaskLabelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachLabel not implemented")
+}
+func (UnimplementedLabelServer) mustEmbedUnimplementedLabelServer() {}
+func (UnimplementedLabelServer) testEmbeddedByValue()               {}
+
+// UnsafeLabelServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LabelServer will
+// result in compilation errors.
+type UnsafeLabelServer interface {
+	mustEmbedUnimplementedLabelServer()
+}
+
+func RegisterLabelServer(s grpc.ServiceRegistrar, srv LabelServer) {
+	// If the following call pancis, it indicates UnimplementedLabelServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Label_ServiceDesc, srv)
+}
+
+func _Label_CreateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).CreateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_CreateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).CreateLabel(ctx, req.(*CreateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Label_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).ListLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_ListLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).ListLabels(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Label_AttachLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).AttachLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_AttachLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).AttachLabel(ctx, req.(*TaskLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Label_DetachLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServer).DetachLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Label_DetachLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServer).DetachLabel(ctx, req.(*TaskLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Label_ServiceDesc is the grpc.ServiceDesc for Label service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Label_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "label.Label",
+	HandlerType: (*LabelServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLabel",
+			Handler:    _Label_CreateLabel_Handler,
+		},
+		{
+			MethodName: "ListLabels",
+			Handler:    _Label_ListLabels_Handler,
+		},
+		{
+			MethodName: "AttachLabel",
+			Handler:    _Label_AttachLabel_Handler,
+		},
+		{
+			MethodName: "DetachLabel",
+			Handler:    _Label_DetachLabel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "label/label.proto",
+}